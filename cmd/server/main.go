@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"syscall"
 	"time"
+	_ "time/tzdata" // часовые пояса пользователей не зависят от tzdata в образе
 
 	"go.uber.org/zap"
 
@@ -51,7 +52,10 @@ func main() {
 
 	logger.Info("Successfully connected to database and ran migrations")
 
-	application := app.NewApp(db)
+	application, err := app.NewApp(db, cfg)
+	if err != nil {
+		logger.Fatal("failed to initialize application", zap.Error(err))
+	}
 	defer application.Close()
 
 	logger.Info("Application initialized successfully")
@@ -0,0 +1,975 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: admin_service.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JobRun представляет один запуск задачи scheduler
+type JobRun struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobName         string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"` // "generate_reminders", "streak_check", "streak_reset_queue", "graduation_check", "challenge_end", "publish_events"
+	Trigger         string                 `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`                // "schedule", "manual", "catch_up"
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                  // "running", "succeeded", "failed"
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	DurationMs      int64                  `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	UsersProcessed  int32                  `protobuf:"varint,8,opt,name=users_processed,json=usersProcessed,proto3" json:"users_processed,omitempty"`
+	HabitsProcessed int32                  `protobuf:"varint,9,opt,name=habits_processed,json=habitsProcessed,proto3" json:"habits_processed,omitempty"`
+	FailedCount     int32                  `protobuf:"varint,10,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Error           string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_admin_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JobRun) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JobRun) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *JobRun) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *JobRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *JobRun) GetUsersProcessed() int32 {
+	if x != nil {
+		return x.UsersProcessed
+	}
+	return 0
+}
+
+func (x *JobRun) GetHabitsProcessed() int32 {
+	if x != nil {
+		return x.HabitsProcessed
+	}
+	return 0
+}
+
+func (x *JobRun) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *JobRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// StreakResetQueueEntry представляет запись очереди сброса стриков
+type StreakResetQueueEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HabitId        int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId         int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ResetDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reset_date,json=resetDate,proto3" json:"reset_date,omitempty"`
+	Processed      bool                   `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError      string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeadLetteredAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FrozenAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"` // пропуск закрыт заморозкой вместо сброса стрика
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreakResetQueueEntry) Reset() {
+	*x = StreakResetQueueEntry{}
+	mi := &file_admin_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreakResetQueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreakResetQueueEntry) ProtoMessage() {}
+
+func (x *StreakResetQueueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreakResetQueueEntry.ProtoReflect.Descriptor instead.
+func (*StreakResetQueueEntry) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreakResetQueueEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StreakResetQueueEntry) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *StreakResetQueueEntry) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StreakResetQueueEntry) GetResetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetDate
+	}
+	return nil
+}
+
+func (x *StreakResetQueueEntry) GetProcessed() bool {
+	if x != nil {
+		return x.Processed
+	}
+	return false
+}
+
+func (x *StreakResetQueueEntry) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *StreakResetQueueEntry) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *StreakResetQueueEntry) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *StreakResetQueueEntry) GetDeadLetteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetteredAt
+	}
+	return nil
+}
+
+func (x *StreakResetQueueEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StreakResetQueueEntry) GetFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return nil
+}
+
+type ListJobRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"` // пусто - все задачи
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                   // по умолчанию и максимум 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsRequest) Reset() {
+	*x = ListJobRunsRequest{}
+	mi := &file_admin_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsRequest) ProtoMessage() {}
+
+func (x *ListJobRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobRunsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListJobRunsRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ListJobRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListJobRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*JobRun              `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsResponse) Reset() {
+	*x = ListJobRunsResponse{}
+	mi := &file_admin_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsResponse) ProtoMessage() {}
+
+func (x *ListJobRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobRunsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListJobRunsResponse) GetRuns() []*JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type GetJobRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRunRequest) Reset() {
+	*x = GetJobRunRequest{}
+	mi := &file_admin_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRunRequest) ProtoMessage() {}
+
+func (x *GetJobRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRunRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRunRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetJobRunRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetJobRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *JobRun                `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRunResponse) Reset() {
+	*x = GetJobRunResponse{}
+	mi := &file_admin_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRunResponse) ProtoMessage() {}
+
+func (x *GetJobRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRunResponse.ProtoReflect.Descriptor instead.
+func (*GetJobRunResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetJobRunResponse) GetRun() *JobRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type TriggerJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobName       string                 `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerJobRequest) Reset() {
+	*x = TriggerJobRequest{}
+	mi := &file_admin_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerJobRequest) ProtoMessage() {}
+
+func (x *TriggerJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerJobRequest.ProtoReflect.Descriptor instead.
+func (*TriggerJobRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TriggerJobRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+type TriggerJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *JobRun                `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerJobResponse) Reset() {
+	*x = TriggerJobResponse{}
+	mi := &file_admin_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerJobResponse) ProtoMessage() {}
+
+func (x *TriggerJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerJobResponse.ProtoReflect.Descriptor instead.
+func (*TriggerJobResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TriggerJobResponse) GetRun() *JobRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type ListDeadLetterEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // по умолчанию и максимум 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLetterEntriesRequest) Reset() {
+	*x = ListDeadLetterEntriesRequest{}
+	mi := &file_admin_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLetterEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetterEntriesRequest) ProtoMessage() {}
+
+func (x *ListDeadLetterEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetterEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLetterEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDeadLetterEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeadLetterEntriesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Entries       []*StreakResetQueueEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLetterEntriesResponse) Reset() {
+	*x = ListDeadLetterEntriesResponse{}
+	mi := &file_admin_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLetterEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLetterEntriesResponse) ProtoMessage() {}
+
+func (x *ListDeadLetterEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLetterEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLetterEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListDeadLetterEntriesResponse) GetEntries() []*StreakResetQueueEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RequeueDeadLetterEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadLetterEntryRequest) Reset() {
+	*x = RequeueDeadLetterEntryRequest{}
+	mi := &file_admin_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadLetterEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadLetterEntryRequest) ProtoMessage() {}
+
+func (x *RequeueDeadLetterEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadLetterEntryRequest.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLetterEntryRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RequeueDeadLetterEntryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RequeueDeadLetterEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *StreakResetQueueEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadLetterEntryResponse) Reset() {
+	*x = RequeueDeadLetterEntryResponse{}
+	mi := &file_admin_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadLetterEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadLetterEntryResponse) ProtoMessage() {}
+
+func (x *RequeueDeadLetterEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadLetterEntryResponse.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLetterEntryResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequeueDeadLetterEntryResponse) GetEntry() *StreakResetQueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GrantFreezeTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantFreezeTokensRequest) Reset() {
+	*x = GrantFreezeTokensRequest{}
+	mi := &file_admin_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantFreezeTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantFreezeTokensRequest) ProtoMessage() {}
+
+func (x *GrantFreezeTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantFreezeTokensRequest.ProtoReflect.Descriptor instead.
+func (*GrantFreezeTokensRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GrantFreezeTokensRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GrantFreezeTokensRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GrantFreezeTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        int32                  `protobuf:"varint,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantFreezeTokensResponse) Reset() {
+	*x = GrantFreezeTokensResponse{}
+	mi := &file_admin_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantFreezeTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantFreezeTokensResponse) ProtoMessage() {}
+
+func (x *GrantFreezeTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantFreezeTokensResponse.ProtoReflect.Descriptor instead.
+func (*GrantFreezeTokensResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GrantFreezeTokensResponse) GetTokens() int32 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+var File_admin_service_proto protoreflect.FileDescriptor
+
+const file_admin_service_proto_rawDesc = "" +
+	"\n" +
+	"\x13admin_service.proto\x12\x0ehobbits.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8b\x03\n" +
+	"\x06JobRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bjob_name\x18\x02 \x01(\tR\ajobName\x12\x18\n" +
+	"\atrigger\x18\x03 \x01(\tR\atrigger\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x1f\n" +
+	"\vduration_ms\x18\a \x01(\x03R\n" +
+	"durationMs\x12'\n" +
+	"\x0fusers_processed\x18\b \x01(\x05R\x0eusersProcessed\x12)\n" +
+	"\x10habits_processed\x18\t \x01(\x05R\x0fhabitsProcessed\x12!\n" +
+	"\ffailed_count\x18\n" +
+	" \x01(\x05R\vfailedCount\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\"\xed\x03\n" +
+	"\x15StreakResetQueueEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x129\n" +
+	"\n" +
+	"reset_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tresetDate\x12\x1c\n" +
+	"\tprocessed\x18\x05 \x01(\bR\tprocessed\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12D\n" +
+	"\x10dead_lettered_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0edeadLetteredAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tfrozen_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bfrozenAt\"E\n" +
+	"\x12ListJobRunsRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"A\n" +
+	"\x13ListJobRunsResponse\x12*\n" +
+	"\x04runs\x18\x01 \x03(\v2\x16.hobbits.api.v1.JobRunR\x04runs\"\"\n" +
+	"\x10GetJobRunRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"=\n" +
+	"\x11GetJobRunResponse\x12(\n" +
+	"\x03run\x18\x01 \x01(\v2\x16.hobbits.api.v1.JobRunR\x03run\".\n" +
+	"\x11TriggerJobRequest\x12\x19\n" +
+	"\bjob_name\x18\x01 \x01(\tR\ajobName\">\n" +
+	"\x12TriggerJobResponse\x12(\n" +
+	"\x03run\x18\x01 \x01(\v2\x16.hobbits.api.v1.JobRunR\x03run\"4\n" +
+	"\x1cListDeadLetterEntriesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"`\n" +
+	"\x1dListDeadLetterEntriesResponse\x12?\n" +
+	"\aentries\x18\x01 \x03(\v2%.hobbits.api.v1.StreakResetQueueEntryR\aentries\"/\n" +
+	"\x1dRequeueDeadLetterEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"]\n" +
+	"\x1eRequeueDeadLetterEntryResponse\x12;\n" +
+	"\x05entry\x18\x01 \x01(\v2%.hobbits.api.v1.StreakResetQueueEntryR\x05entry\"K\n" +
+	"\x18GrantFreezeTokensRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\"3\n" +
+	"\x19GrantFreezeTokensResponse\x12\x16\n" +
+	"\x06tokens\x18\x01 \x01(\x05R\x06tokens2\xe6\x04\n" +
+	"\fAdminService\x12V\n" +
+	"\vListJobRuns\x12\".hobbits.api.v1.ListJobRunsRequest\x1a#.hobbits.api.v1.ListJobRunsResponse\x12P\n" +
+	"\tGetJobRun\x12 .hobbits.api.v1.GetJobRunRequest\x1a!.hobbits.api.v1.GetJobRunResponse\x12S\n" +
+	"\n" +
+	"TriggerJob\x12!.hobbits.api.v1.TriggerJobRequest\x1a\".hobbits.api.v1.TriggerJobResponse\x12t\n" +
+	"\x15ListDeadLetterEntries\x12,.hobbits.api.v1.ListDeadLetterEntriesRequest\x1a-.hobbits.api.v1.ListDeadLetterEntriesResponse\x12w\n" +
+	"\x16RequeueDeadLetterEntry\x12-.hobbits.api.v1.RequeueDeadLetterEntryRequest\x1a..hobbits.api.v1.RequeueDeadLetterEntryResponse\x12h\n" +
+	"\x11GrantFreezeTokens\x12(.hobbits.api.v1.GrantFreezeTokensRequest\x1a).hobbits.api.v1.GrantFreezeTokensResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
+
+var (
+	file_admin_service_proto_rawDescOnce sync.Once
+	file_admin_service_proto_rawDescData []byte
+)
+
+func file_admin_service_proto_rawDescGZIP() []byte {
+	file_admin_service_proto_rawDescOnce.Do(func() {
+		file_admin_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_service_proto_rawDesc), len(file_admin_service_proto_rawDesc)))
+	})
+	return file_admin_service_proto_rawDescData
+}
+
+var file_admin_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_admin_service_proto_goTypes = []any{
+	(*JobRun)(nil),                         // 0: hobbits.api.v1.JobRun
+	(*StreakResetQueueEntry)(nil),          // 1: hobbits.api.v1.StreakResetQueueEntry
+	(*ListJobRunsRequest)(nil),             // 2: hobbits.api.v1.ListJobRunsRequest
+	(*ListJobRunsResponse)(nil),            // 3: hobbits.api.v1.ListJobRunsResponse
+	(*GetJobRunRequest)(nil),               // 4: hobbits.api.v1.GetJobRunRequest
+	(*GetJobRunResponse)(nil),              // 5: hobbits.api.v1.GetJobRunResponse
+	(*TriggerJobRequest)(nil),              // 6: hobbits.api.v1.TriggerJobRequest
+	(*TriggerJobResponse)(nil),             // 7: hobbits.api.v1.TriggerJobResponse
+	(*ListDeadLetterEntriesRequest)(nil),   // 8: hobbits.api.v1.ListDeadLetterEntriesRequest
+	(*ListDeadLetterEntriesResponse)(nil),  // 9: hobbits.api.v1.ListDeadLetterEntriesResponse
+	(*RequeueDeadLetterEntryRequest)(nil),  // 10: hobbits.api.v1.RequeueDeadLetterEntryRequest
+	(*RequeueDeadLetterEntryResponse)(nil), // 11: hobbits.api.v1.RequeueDeadLetterEntryResponse
+	(*GrantFreezeTokensRequest)(nil),       // 12: hobbits.api.v1.GrantFreezeTokensRequest
+	(*GrantFreezeTokensResponse)(nil),      // 13: hobbits.api.v1.GrantFreezeTokensResponse
+	(*timestamppb.Timestamp)(nil),          // 14: google.protobuf.Timestamp
+}
+var file_admin_service_proto_depIdxs = []int32{
+	14, // 0: hobbits.api.v1.JobRun.started_at:type_name -> google.protobuf.Timestamp
+	14, // 1: hobbits.api.v1.JobRun.finished_at:type_name -> google.protobuf.Timestamp
+	14, // 2: hobbits.api.v1.StreakResetQueueEntry.reset_date:type_name -> google.protobuf.Timestamp
+	14, // 3: hobbits.api.v1.StreakResetQueueEntry.next_attempt_at:type_name -> google.protobuf.Timestamp
+	14, // 4: hobbits.api.v1.StreakResetQueueEntry.dead_lettered_at:type_name -> google.protobuf.Timestamp
+	14, // 5: hobbits.api.v1.StreakResetQueueEntry.created_at:type_name -> google.protobuf.Timestamp
+	14, // 6: hobbits.api.v1.StreakResetQueueEntry.frozen_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: hobbits.api.v1.ListJobRunsResponse.runs:type_name -> hobbits.api.v1.JobRun
+	0,  // 8: hobbits.api.v1.GetJobRunResponse.run:type_name -> hobbits.api.v1.JobRun
+	0,  // 9: hobbits.api.v1.TriggerJobResponse.run:type_name -> hobbits.api.v1.JobRun
+	1,  // 10: hobbits.api.v1.ListDeadLetterEntriesResponse.entries:type_name -> hobbits.api.v1.StreakResetQueueEntry
+	1,  // 11: hobbits.api.v1.RequeueDeadLetterEntryResponse.entry:type_name -> hobbits.api.v1.StreakResetQueueEntry
+	2,  // 12: hobbits.api.v1.AdminService.ListJobRuns:input_type -> hobbits.api.v1.ListJobRunsRequest
+	4,  // 13: hobbits.api.v1.AdminService.GetJobRun:input_type -> hobbits.api.v1.GetJobRunRequest
+	6,  // 14: hobbits.api.v1.AdminService.TriggerJob:input_type -> hobbits.api.v1.TriggerJobRequest
+	8,  // 15: hobbits.api.v1.AdminService.ListDeadLetterEntries:input_type -> hobbits.api.v1.ListDeadLetterEntriesRequest
+	10, // 16: hobbits.api.v1.AdminService.RequeueDeadLetterEntry:input_type -> hobbits.api.v1.RequeueDeadLetterEntryRequest
+	12, // 17: hobbits.api.v1.AdminService.GrantFreezeTokens:input_type -> hobbits.api.v1.GrantFreezeTokensRequest
+	3,  // 18: hobbits.api.v1.AdminService.ListJobRuns:output_type -> hobbits.api.v1.ListJobRunsResponse
+	5,  // 19: hobbits.api.v1.AdminService.GetJobRun:output_type -> hobbits.api.v1.GetJobRunResponse
+	7,  // 20: hobbits.api.v1.AdminService.TriggerJob:output_type -> hobbits.api.v1.TriggerJobResponse
+	9,  // 21: hobbits.api.v1.AdminService.ListDeadLetterEntries:output_type -> hobbits.api.v1.ListDeadLetterEntriesResponse
+	11, // 22: hobbits.api.v1.AdminService.RequeueDeadLetterEntry:output_type -> hobbits.api.v1.RequeueDeadLetterEntryResponse
+	13, // 23: hobbits.api.v1.AdminService.GrantFreezeTokens:output_type -> hobbits.api.v1.GrantFreezeTokensResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_admin_service_proto_init() }
+func file_admin_service_proto_init() {
+	if File_admin_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_service_proto_rawDesc), len(file_admin_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_service_proto_goTypes,
+		DependencyIndexes: file_admin_service_proto_depIdxs,
+		MessageInfos:      file_admin_service_proto_msgTypes,
+	}.Build()
+	File_admin_service_proto = out.File
+	file_admin_service_proto_goTypes = nil
+	file_admin_service_proto_depIdxs = nil
+}
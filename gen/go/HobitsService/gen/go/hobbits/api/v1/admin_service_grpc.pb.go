@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: admin_service.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_ListJobRuns_FullMethodName            = "/hobbits.api.v1.AdminService/ListJobRuns"
+	AdminService_GetJobRun_FullMethodName              = "/hobbits.api.v1.AdminService/GetJobRun"
+	AdminService_TriggerJob_FullMethodName             = "/hobbits.api.v1.AdminService/TriggerJob"
+	AdminService_ListDeadLetterEntries_FullMethodName  = "/hobbits.api.v1.AdminService/ListDeadLetterEntries"
+	AdminService_RequeueDeadLetterEntry_FullMethodName = "/hobbits.api.v1.AdminService/RequeueDeadLetterEntry"
+	AdminService_GrantFreezeTokens_FullMethodName      = "/hobbits.api.v1.AdminService/GrantFreezeTokens"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService для наблюдения за задачами scheduler, их ручного запуска и выдачи заморозок
+type AdminServiceClient interface {
+	// ListJobRuns получает последние запуски задач
+	ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsResponse, error)
+	// GetJobRun получает запуск задачи по ID
+	GetJobRun(ctx context.Context, in *GetJobRunRequest, opts ...grpc.CallOption) (*GetJobRunResponse, error)
+	// TriggerJob запускает задачу вне расписания
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobResponse, error)
+	// ListDeadLetterEntries получает записи очереди сброса стриков, исчерпавшие попытки обработки
+	ListDeadLetterEntries(ctx context.Context, in *ListDeadLetterEntriesRequest, opts ...grpc.CallOption) (*ListDeadLetterEntriesResponse, error)
+	// RequeueDeadLetterEntry возвращает запись из dead letter в очередь
+	RequeueDeadLetterEntry(ctx context.Context, in *RequeueDeadLetterEntryRequest, opts ...grpc.CallOption) (*RequeueDeadLetterEntryResponse, error)
+	// GrantFreezeTokens выдает пользователю заморозки
+	GrantFreezeTokens(ctx context.Context, in *GrantFreezeTokensRequest, opts ...grpc.CallOption) (*GrantFreezeTokensResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobRunsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListJobRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetJobRun(ctx context.Context, in *GetJobRunRequest, opts ...grpc.CallOption) (*GetJobRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobRunResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetJobRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerJobResponse)
+	err := c.cc.Invoke(ctx, AdminService_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDeadLetterEntries(ctx context.Context, in *ListDeadLetterEntriesRequest, opts ...grpc.CallOption) (*ListDeadLetterEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLetterEntriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDeadLetterEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RequeueDeadLetterEntry(ctx context.Context, in *RequeueDeadLetterEntryRequest, opts ...grpc.CallOption) (*RequeueDeadLetterEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeueDeadLetterEntryResponse)
+	err := c.cc.Invoke(ctx, AdminService_RequeueDeadLetterEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GrantFreezeTokens(ctx context.Context, in *GrantFreezeTokensRequest, opts ...grpc.CallOption) (*GrantFreezeTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantFreezeTokensResponse)
+	err := c.cc.Invoke(ctx, AdminService_GrantFreezeTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService для наблюдения за задачами scheduler, их ручного запуска и выдачи заморозок
+type AdminServiceServer interface {
+	// ListJobRuns получает последние запуски задач
+	ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsResponse, error)
+	// GetJobRun получает запуск задачи по ID
+	GetJobRun(context.Context, *GetJobRunRequest) (*GetJobRunResponse, error)
+	// TriggerJob запускает задачу вне расписания
+	TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobResponse, error)
+	// ListDeadLetterEntries получает записи очереди сброса стриков, исчерпавшие попытки обработки
+	ListDeadLetterEntries(context.Context, *ListDeadLetterEntriesRequest) (*ListDeadLetterEntriesResponse, error)
+	// RequeueDeadLetterEntry возвращает запись из dead letter в очередь
+	RequeueDeadLetterEntry(context.Context, *RequeueDeadLetterEntryRequest) (*RequeueDeadLetterEntryResponse, error)
+	// GrantFreezeTokens выдает пользователю заморозки
+	GrantFreezeTokens(context.Context, *GrantFreezeTokensRequest) (*GrantFreezeTokensResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobRuns not implemented")
+}
+func (UnimplementedAdminServiceServer) GetJobRun(context.Context, *GetJobRunRequest) (*GetJobRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRun not implemented")
+}
+func (UnimplementedAdminServiceServer) TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDeadLetterEntries(context.Context, *ListDeadLetterEntriesRequest) (*ListDeadLetterEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetterEntries not implemented")
+}
+func (UnimplementedAdminServiceServer) RequeueDeadLetterEntry(context.Context, *RequeueDeadLetterEntryRequest) (*RequeueDeadLetterEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueDeadLetterEntry not implemented")
+}
+func (UnimplementedAdminServiceServer) GrantFreezeTokens(context.Context, *GrantFreezeTokensRequest) (*GrantFreezeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantFreezeTokens not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListJobRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListJobRuns(ctx, req.(*ListJobRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetJobRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetJobRun(ctx, req.(*GetJobRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TriggerJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TriggerJob(ctx, req.(*TriggerJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDeadLetterEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDeadLetterEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDeadLetterEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDeadLetterEntries(ctx, req.(*ListDeadLetterEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RequeueDeadLetterEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueDeadLetterEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RequeueDeadLetterEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RequeueDeadLetterEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RequeueDeadLetterEntry(ctx, req.(*RequeueDeadLetterEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GrantFreezeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantFreezeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GrantFreezeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GrantFreezeTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GrantFreezeTokens(ctx, req.(*GrantFreezeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hobbits.api.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListJobRuns",
+			Handler:    _AdminService_ListJobRuns_Handler,
+		},
+		{
+			MethodName: "GetJobRun",
+			Handler:    _AdminService_GetJobRun_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _AdminService_TriggerJob_Handler,
+		},
+		{
+			MethodName: "ListDeadLetterEntries",
+			Handler:    _AdminService_ListDeadLetterEntries_Handler,
+		},
+		{
+			MethodName: "RequeueDeadLetterEntry",
+			Handler:    _AdminService_RequeueDeadLetterEntry_Handler,
+		},
+		{
+			MethodName: "GrantFreezeTokens",
+			Handler:    _AdminService_GrantFreezeTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin_service.proto",
+}
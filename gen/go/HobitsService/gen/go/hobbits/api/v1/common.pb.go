@@ -24,17 +24,20 @@ const (
 
 // User представляет пользователя
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TelegramId    int64                  `protobuf:"varint,2,opt,name=telegram_id,json=telegramId,proto3" json:"telegram_id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	LanguageCode  string                 `protobuf:"bytes,6,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TelegramId      int64                  `protobuf:"varint,2,opt,name=telegram_id,json=telegramId,proto3" json:"telegram_id,omitempty"`
+	FirstName       string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Username        string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	LanguageCode    string                 `protobuf:"bytes,6,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Timezone        string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`                                          // IANA, например "Europe/Lisbon"
+	DayStartHour    int32                  `protobuf:"varint,10,opt,name=day_start_hour,json=dayStartHour,proto3" json:"day_start_hour,omitempty"`          // час начала дня, 0-23
+	LogGraceMinutes int32                  `protobuf:"varint,11,opt,name=log_grace_minutes,json=logGraceMinutes,proto3" json:"log_grace_minutes,omitempty"` // окно льготы после начала дня, выполнение засчитывается во вчера
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -123,6 +126,27 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *User) GetDayStartHour() int32 {
+	if x != nil {
+		return x.DayStartHour
+	}
+	return 0
+}
+
+func (x *User) GetLogGraceMinutes() int32 {
+	if x != nil {
+		return x.LogGraceMinutes
+	}
+	return 0
+}
+
 // Habit представляет привычку
 type Habit struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -131,9 +155,9 @@ type Habit struct {
 	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Goal              string                 `protobuf:"bytes,5,opt,name=goal,proto3" json:"goal,omitempty"`
-	Frequency         string                 `protobuf:"bytes,6,opt,name=frequency,proto3" json:"frequency,omitempty"`                        // "daily", "weekly", "monthly"
+	Frequency         string                 `protobuf:"bytes,6,opt,name=frequency,proto3" json:"frequency,omitempty"`                        // "daily", "weekly", "monthly", "interval", "quota", "quit"
 	WeeklyDays        string                 `protobuf:"bytes,7,opt,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"`    // "1,3,5" for weekly
-	MonthlyDays       string                 `protobuf:"bytes,8,opt,name=monthly_days,json=monthlyDays,proto3" json:"monthly_days,omitempty"` // "1,15,28" for monthly
+	MonthlyDays       string                 `protobuf:"bytes,8,opt,name=monthly_days,json=monthlyDays,proto3" json:"monthly_days,omitempty"` // "1,15,31,last,2TUE" for monthly
 	CurrentStreak     int32                  `protobuf:"varint,9,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
 	BestStreak        int32                  `protobuf:"varint,10,opt,name=best_streak,json=bestStreak,proto3" json:"best_streak,omitempty"`
 	LastCompletedDate *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_completed_date,json=lastCompletedDate,proto3" json:"last_completed_date,omitempty"`
@@ -143,6 +167,20 @@ type Habit struct {
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CompletedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	IntervalDays      int32                  `protobuf:"varint,18,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"`             // for interval
+	AnchorDate        *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=anchor_date,json=anchorDate,proto3" json:"anchor_date,omitempty"`                    // for interval
+	QuotaTarget       int32                  `protobuf:"varint,20,opt,name=quota_target,json=quotaTarget,proto3" json:"quota_target,omitempty"`                // for quota
+	QuotaPeriod       string                 `protobuf:"bytes,21,opt,name=quota_period,json=quotaPeriod,proto3" json:"quota_period,omitempty"`                 // for quota: "week", "month"
+	Rrule             string                 `protobuf:"bytes,22,opt,name=rrule,proto3" json:"rrule,omitempty"`                                                // RFC 5545, если задано - определяет расписание
+	RruleStart        *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=rrule_start,json=rruleStart,proto3" json:"rrule_start,omitempty"`                    // DTSTART правила
+	TargetValue       float64                `protobuf:"fixed64,24,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`               // дневная цель количественной привычки, 0 - бинарная
+	Unit              string                 `protobuf:"bytes,25,opt,name=unit,proto3" json:"unit,omitempty"`                                                  // единица цели: "страниц", "л", "мин"
+	DailyCount        int32                  `protobuf:"varint,26,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`                   // сколько раз за день нужно выполнить, по умолчанию 1
+	GraduationStreak  int32                  `protobuf:"varint,27,opt,name=graduation_streak,json=graduationStreak,proto3" json:"graduation_streak,omitempty"` // стрик, после которого привычка выработана, 0 - не задан
+	GraduationRate    int32                  `protobuf:"varint,28,opt,name=graduation_rate,json=graduationRate,proto3" json:"graduation_rate,omitempty"`       // процент выполнения для выработки, 0 - не задан
+	GraduationWeeks   int32                  `protobuf:"varint,29,opt,name=graduation_weeks,json=graduationWeeks,proto3" json:"graduation_weeks,omitempty"`    // за сколько недель считается graduation_rate
+	StartsOn          *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`                          // начало челленджа
+	EndsOn            *timestamppb.Timestamp `protobuf:"bytes,31,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`                                // окончание челленджа
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -296,17 +334,117 @@ func (x *Habit) GetCompletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Habit) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
+}
+
+func (x *Habit) GetAnchorDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnchorDate
+	}
+	return nil
+}
+
+func (x *Habit) GetQuotaTarget() int32 {
+	if x != nil {
+		return x.QuotaTarget
+	}
+	return 0
+}
+
+func (x *Habit) GetQuotaPeriod() string {
+	if x != nil {
+		return x.QuotaPeriod
+	}
+	return ""
+}
+
+func (x *Habit) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *Habit) GetRruleStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RruleStart
+	}
+	return nil
+}
+
+func (x *Habit) GetTargetValue() float64 {
+	if x != nil {
+		return x.TargetValue
+	}
+	return 0
+}
+
+func (x *Habit) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Habit) GetDailyCount() int32 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+func (x *Habit) GetGraduationStreak() int32 {
+	if x != nil {
+		return x.GraduationStreak
+	}
+	return 0
+}
+
+func (x *Habit) GetGraduationRate() int32 {
+	if x != nil {
+		return x.GraduationRate
+	}
+	return 0
+}
+
+func (x *Habit) GetGraduationWeeks() int32 {
+	if x != nil {
+		return x.GraduationWeeks
+	}
+	return 0
+}
+
+func (x *Habit) GetStartsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsOn
+	}
+	return nil
+}
+
+func (x *Habit) GetEndsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsOn
+	}
+	return nil
+}
+
 // HabitLog представляет логирование выполнения привычки
 type HabitLog struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	HabitId       int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	LoggedDate    string                 `protobuf:"bytes,5,opt,name=logged_date,json=loggedDate,proto3" json:"logged_date,omitempty"` // ISO 8601 date
-	LoggedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=logged_at,json=loggedAt,proto3" json:"logged_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HabitId          int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId           int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Comment          string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	LoggedDate       string                 `protobuf:"bytes,5,opt,name=logged_date,json=loggedDate,proto3" json:"logged_date,omitempty"` // ISO 8601 date
+	LoggedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=logged_at,json=loggedAt,proto3" json:"logged_at,omitempty"`
+	Value            float64                `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`                                              // сумма значений за день для количественной привычки
+	CompletionNumber int32                  `protobuf:"varint,8,opt,name=completion_number,json=completionNumber,proto3" json:"completion_number,omitempty"` // номер выполнения за день, начиная с 1
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *HabitLog) Reset() {
@@ -381,6 +519,20 @@ func (x *HabitLog) GetLoggedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *HabitLog) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HabitLog) GetCompletionNumber() int32 {
+	if x != nil {
+		return x.CompletionNumber
+	}
+	return 0
+}
+
 // HabitReminder представляет напоминание о привычке
 type HabitReminder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -390,6 +542,7 @@ type HabitReminder struct {
 	ReminderDate  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reminder_date,json=reminderDate,proto3" json:"reminder_date,omitempty"`
 	IsCompleted   bool                   `protobuf:"varint,5,opt,name=is_completed,json=isCompleted,proto3" json:"is_completed,omitempty"`
 	SentAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	IsSkipped     bool                   `protobuf:"varint,7,opt,name=is_skipped,json=isSkipped,proto3" json:"is_skipped,omitempty"` // день пропущен по уважительной причине
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -466,6 +619,13 @@ func (x *HabitReminder) GetSentAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *HabitReminder) GetIsSkipped() bool {
+	if x != nil {
+		return x.IsSkipped
+	}
+	return false
+}
+
 // CompletionStats представляет статистику выполнения
 type CompletionStats struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -608,7 +768,7 @@ var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
 	"\n" +
-	"\fcommon.proto\x12\x0ehobbits.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x02\n" +
+	"\fcommon.proto\x12\x0ehobbits.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x98\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vtelegram_id\x18\x02 \x01(\x03R\n" +
@@ -621,7 +781,11 @@ const file_common_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xad\x05\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\x12$\n" +
+	"\x0eday_start_hour\x18\n" +
+	" \x01(\x05R\fdayStartHour\x12*\n" +
+	"\x11log_grace_minutes\x18\v \x01(\x05R\x0flogGraceMinutes\"\xef\t\n" +
 	"\x05Habit\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
@@ -644,7 +808,24 @@ const file_common_proto_rawDesc = "" +
 	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
-	"\fcompleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xc2\x01\n" +
+	"\fcompleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12#\n" +
+	"\rinterval_days\x18\x12 \x01(\x05R\fintervalDays\x12;\n" +
+	"\vanchor_date\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"anchorDate\x12!\n" +
+	"\fquota_target\x18\x14 \x01(\x05R\vquotaTarget\x12!\n" +
+	"\fquota_period\x18\x15 \x01(\tR\vquotaPeriod\x12\x14\n" +
+	"\x05rrule\x18\x16 \x01(\tR\x05rrule\x12;\n" +
+	"\vrrule_start\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"rruleStart\x12!\n" +
+	"\ftarget_value\x18\x18 \x01(\x01R\vtargetValue\x12\x12\n" +
+	"\x04unit\x18\x19 \x01(\tR\x04unit\x12\x1f\n" +
+	"\vdaily_count\x18\x1a \x01(\x05R\n" +
+	"dailyCount\x12+\n" +
+	"\x11graduation_streak\x18\x1b \x01(\x05R\x10graduationStreak\x12'\n" +
+	"\x0fgraduation_rate\x18\x1c \x01(\x05R\x0egraduationRate\x12)\n" +
+	"\x10graduation_weeks\x18\x1d \x01(\x05R\x0fgraduationWeeks\x127\n" +
+	"\tstarts_on\x18\x1e \x01(\v2\x1a.google.protobuf.TimestampR\bstartsOn\x123\n" +
+	"\aends_on\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampR\x06endsOn\"\x85\x02\n" +
 	"\bHabitLog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\x12\x17\n" +
@@ -652,14 +833,18 @@ const file_common_proto_rawDesc = "" +
 	"\acomment\x18\x04 \x01(\tR\acomment\x12\x1f\n" +
 	"\vlogged_date\x18\x05 \x01(\tR\n" +
 	"loggedDate\x127\n" +
-	"\tlogged_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bloggedAt\"\xec\x01\n" +
+	"\tlogged_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bloggedAt\x12\x14\n" +
+	"\x05value\x18\a \x01(\x01R\x05value\x12+\n" +
+	"\x11completion_number\x18\b \x01(\x05R\x10completionNumber\"\x8b\x02\n" +
 	"\rHabitReminder\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12?\n" +
 	"\rreminder_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\freminderDate\x12!\n" +
 	"\fis_completed\x18\x05 \x01(\bR\visCompleted\x123\n" +
-	"\asent_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\"\xbf\x01\n" +
+	"\asent_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\a \x01(\bR\tisSkipped\"\xbf\x01\n" +
 	"\x0fCompletionStats\x12\x19\n" +
 	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12'\n" +
 	"\x0fcompleted_count\x18\x02 \x01(\x05R\x0ecompletedCount\x12'\n" +
@@ -701,14 +886,18 @@ var file_common_proto_depIdxs = []int32{
 	6,  // 4: hobbits.api.v1.Habit.created_at:type_name -> google.protobuf.Timestamp
 	6,  // 5: hobbits.api.v1.Habit.updated_at:type_name -> google.protobuf.Timestamp
 	6,  // 6: hobbits.api.v1.Habit.completed_at:type_name -> google.protobuf.Timestamp
-	6,  // 7: hobbits.api.v1.HabitLog.logged_at:type_name -> google.protobuf.Timestamp
-	6,  // 8: hobbits.api.v1.HabitReminder.reminder_date:type_name -> google.protobuf.Timestamp
-	6,  // 9: hobbits.api.v1.HabitReminder.sent_at:type_name -> google.protobuf.Timestamp
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	6,  // 7: hobbits.api.v1.Habit.anchor_date:type_name -> google.protobuf.Timestamp
+	6,  // 8: hobbits.api.v1.Habit.rrule_start:type_name -> google.protobuf.Timestamp
+	6,  // 9: hobbits.api.v1.Habit.starts_on:type_name -> google.protobuf.Timestamp
+	6,  // 10: hobbits.api.v1.Habit.ends_on:type_name -> google.protobuf.Timestamp
+	6,  // 11: hobbits.api.v1.HabitLog.logged_at:type_name -> google.protobuf.Timestamp
+	6,  // 12: hobbits.api.v1.HabitReminder.reminder_date:type_name -> google.protobuf.Timestamp
+	6,  // 13: hobbits.api.v1.HabitReminder.sent_at:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -9,6 +9,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,11 +26,22 @@ type CreateHabitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Frequency     string                 `protobuf:"bytes,3,opt,name=frequency,proto3" json:"frequency,omitempty"` // "daily", "weekly", "monthly"
+	Frequency     string                 `protobuf:"bytes,3,opt,name=frequency,proto3" json:"frequency,omitempty"` // "daily", "weekly", "monthly", "interval", "quota", "quit"
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Goal          string                 `protobuf:"bytes,5,opt,name=goal,proto3" json:"goal,omitempty"`
-	WeeklyDays    string                 `protobuf:"bytes,6,opt,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"`    // for weekly: "1,3,5"
-	MonthlyDays   string                 `protobuf:"bytes,7,opt,name=monthly_days,json=monthlyDays,proto3" json:"monthly_days,omitempty"` // for monthly: "1,15,28"
+	WeeklyDays    string                 `protobuf:"bytes,6,opt,name=weekly_days,json=weeklyDays,proto3" json:"weekly_days,omitempty"`        // for weekly: "1,3,5"
+	MonthlyDays   string                 `protobuf:"bytes,7,opt,name=monthly_days,json=monthlyDays,proto3" json:"monthly_days,omitempty"`     // for monthly: "1,15,31,last,2nd TUE"
+	IntervalDays  int32                  `protobuf:"varint,8,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"` // for interval: каждые N дней
+	AnchorDate    string                 `protobuf:"bytes,9,opt,name=anchor_date,json=anchorDate,proto3" json:"anchor_date,omitempty"`        // for interval: ISO 8601 date отсчета, по умолчанию сегодня
+	QuotaTarget   int32                  `protobuf:"varint,10,opt,name=quota_target,json=quotaTarget,proto3" json:"quota_target,omitempty"`   // for quota: сколько раз за период
+	QuotaPeriod   string                 `protobuf:"bytes,11,opt,name=quota_period,json=quotaPeriod,proto3" json:"quota_period,omitempty"`    // for quota: "week", "month"
+	Rrule         string                 `protobuf:"bytes,12,opt,name=rrule,proto3" json:"rrule,omitempty"`                                   // RFC 5545: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", заменяет frequency
+	RruleStart    string                 `protobuf:"bytes,13,opt,name=rrule_start,json=rruleStart,proto3" json:"rrule_start,omitempty"`       // ISO 8601 date начала (DTSTART), по умолчанию сегодня
+	TargetValue   float64                `protobuf:"fixed64,14,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`  // дневная цель: 20 страниц, 2 литра; 0 - бинарная привычка
+	Unit          string                 `protobuf:"bytes,15,opt,name=unit,proto3" json:"unit,omitempty"`                                     // единица цели
+	DailyCount    int32                  `protobuf:"varint,16,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`      // сколько раз за день: "медитировать дважды", по умолчанию 1
+	StartsOn      string                 `protobuf:"bytes,17,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`             // ISO 8601 date начала челленджа, пусто - без ограничения
+	EndsOn        string                 `protobuf:"bytes,18,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`                   // ISO 8601 date окончания челленджа, после него привычка деактивируется
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -113,6 +125,83 @@ func (x *CreateHabitRequest) GetMonthlyDays() string {
 	return ""
 }
 
+func (x *CreateHabitRequest) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
+}
+
+func (x *CreateHabitRequest) GetAnchorDate() string {
+	if x != nil {
+		return x.AnchorDate
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetQuotaTarget() int32 {
+	if x != nil {
+		return x.QuotaTarget
+	}
+	return 0
+}
+
+func (x *CreateHabitRequest) GetQuotaPeriod() string {
+	if x != nil {
+		return x.QuotaPeriod
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetRruleStart() string {
+	if x != nil {
+		return x.RruleStart
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetTargetValue() float64 {
+	if x != nil {
+		return x.TargetValue
+	}
+	return 0
+}
+
+func (x *CreateHabitRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetDailyCount() int32 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+func (x *CreateHabitRequest) GetStartsOn() string {
+	if x != nil {
+		return x.StartsOn
+	}
+	return ""
+}
+
+func (x *CreateHabitRequest) GetEndsOn() string {
+	if x != nil {
+		return x.EndsOn
+	}
+	return ""
+}
+
 type CreateHabitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
@@ -720,7 +809,9 @@ func (x *SetWeeklyDaysResponse) GetHabit() *Habit {
 type SetMonthlyDaysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
-	Days          []int32                `protobuf:"varint,2,rep,packed,name=days,proto3" json:"days,omitempty"` // 1-28
+	Days          []int32                `protobuf:"varint,2,rep,packed,name=days,proto3" json:"days,omitempty"`               // 1-31, в коротких месяцах 29-31 переносятся на последний день
+	LastDay       bool                   `protobuf:"varint,3,opt,name=last_day,json=lastDay,proto3" json:"last_day,omitempty"` // последний день месяца
+	Weekdays      []string               `protobuf:"bytes,4,rep,name=weekdays,proto3" json:"weekdays,omitempty"`               // N-й день недели: "1st MON", "2nd TUE", "last FRI"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -769,6 +860,20 @@ func (x *SetMonthlyDaysRequest) GetDays() []int32 {
 	return nil
 }
 
+func (x *SetMonthlyDaysRequest) GetLastDay() bool {
+	if x != nil {
+		return x.LastDay
+	}
+	return false
+}
+
+func (x *SetMonthlyDaysRequest) GetWeekdays() []string {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
 type SetMonthlyDaysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
@@ -813,27 +918,29 @@ func (x *SetMonthlyDaysResponse) GetHabit() *Habit {
 	return nil
 }
 
-type IsScheduledTodayRequest struct {
+type SetRecurrenceRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	Rrule         string                 `protobuf:"bytes,2,opt,name=rrule,proto3" json:"rrule,omitempty"`                          // пустое значение убирает правило
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO 8601 date начала (DTSTART), по умолчанию сегодня
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsScheduledTodayRequest) Reset() {
-	*x = IsScheduledTodayRequest{}
+func (x *SetRecurrenceRuleRequest) Reset() {
+	*x = SetRecurrenceRuleRequest{}
 	mi := &file_habit_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsScheduledTodayRequest) String() string {
+func (x *SetRecurrenceRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsScheduledTodayRequest) ProtoMessage() {}
+func (*SetRecurrenceRuleRequest) ProtoMessage() {}
 
-func (x *IsScheduledTodayRequest) ProtoReflect() protoreflect.Message {
+func (x *SetRecurrenceRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_habit_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -845,39 +952,53 @@ func (x *IsScheduledTodayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsScheduledTodayRequest.ProtoReflect.Descriptor instead.
-func (*IsScheduledTodayRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetRecurrenceRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetRecurrenceRuleRequest) Descriptor() ([]byte, []int) {
 	return file_habit_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *IsScheduledTodayRequest) GetHabitId() int32 {
+func (x *SetRecurrenceRuleRequest) GetHabitId() int32 {
 	if x != nil {
 		return x.HabitId
 	}
 	return 0
 }
 
-type IsScheduledTodayResponse struct {
+func (x *SetRecurrenceRuleRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *SetRecurrenceRuleRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+type SetRecurrenceRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Scheduled     bool                   `protobuf:"varint,1,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IsScheduledTodayResponse) Reset() {
-	*x = IsScheduledTodayResponse{}
+func (x *SetRecurrenceRuleResponse) Reset() {
+	*x = SetRecurrenceRuleResponse{}
 	mi := &file_habit_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsScheduledTodayResponse) String() string {
+func (x *SetRecurrenceRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsScheduledTodayResponse) ProtoMessage() {}
+func (*SetRecurrenceRuleResponse) ProtoMessage() {}
 
-func (x *IsScheduledTodayResponse) ProtoReflect() protoreflect.Message {
+func (x *SetRecurrenceRuleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_habit_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -889,71 +1010,974 @@ func (x *IsScheduledTodayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsScheduledTodayResponse.ProtoReflect.Descriptor instead.
-func (*IsScheduledTodayResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetRecurrenceRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetRecurrenceRuleResponse) Descriptor() ([]byte, []int) {
 	return file_habit_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *IsScheduledTodayResponse) GetScheduled() bool {
+func (x *SetRecurrenceRuleResponse) GetHabit() *Habit {
 	if x != nil {
-		return x.Scheduled
+		return x.Habit
 	}
-	return false
+	return nil
 }
 
-var File_habit_service_proto protoreflect.FileDescriptor
+type SetTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	TargetValue   float64                `protobuf:"fixed64,2,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"` // 0 делает привычку бинарной
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_habit_service_proto_rawDesc = "" +
-	"\n" +
-	"\x13habit_service.proto\x12\x0ehobbits.api.v1\x1a\fcommon.proto\"\xd9\x01\n" +
-	"\x12CreateHabitRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
-	"\tfrequency\x18\x03 \x01(\tR\tfrequency\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04goal\x18\x05 \x01(\tR\x04goal\x12\x1f\n" +
-	"\vweekly_days\x18\x06 \x01(\tR\n" +
-	"weeklyDays\x12!\n" +
-	"\fmonthly_days\x18\a \x01(\tR\vmonthlyDays\"B\n" +
-	"\x13CreateHabitResponse\x12+\n" +
-	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"!\n" +
-	"\x0fGetHabitRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"?\n" +
-	"\x10GetHabitResponse\x12+\n" +
-	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"/\n" +
-	"\x14GetUserHabitsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"F\n" +
-	"\x15GetUserHabitsResponse\x12-\n" +
-	"\x06habits\x18\x01 \x03(\v2\x15.hobbits.api.v1.HabitR\x06habits\"1\n" +
-	"\x16GetActiveHabitsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"H\n" +
-	"\x17GetActiveHabitsResponse\x12-\n" +
-	"\x06habits\x18\x01 \x03(\v2\x15.hobbits.api.v1.HabitR\x06habits\"n\n" +
-	"\x12UpdateHabitRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04goal\x18\x04 \x01(\tR\x04goal\"B\n" +
-	"\x13UpdateHabitResponse\x12+\n" +
-	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"$\n" +
-	"\x12DeleteHabitRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"/\n" +
-	"\x13DeleteHabitResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
-	"\x14SetWeeklyDaysRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x12\n" +
-	"\x04days\x18\x02 \x03(\x05R\x04days\"D\n" +
-	"\x15SetWeeklyDaysResponse\x12+\n" +
-	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"F\n" +
-	"\x15SetMonthlyDaysRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x12\n" +
-	"\x04days\x18\x02 \x03(\x05R\x04days\"E\n" +
-	"\x16SetMonthlyDaysResponse\x12+\n" +
-	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"4\n" +
-	"\x17IsScheduledTodayRequest\x12\x19\n" +
-	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\"8\n" +
-	"\x18IsScheduledTodayResponse\x12\x1c\n" +
-	"\tscheduled\x18\x01 \x01(\bR\tscheduled2\xcd\x06\n" +
+func (x *SetTargetRequest) Reset() {
+	*x = SetTargetRequest{}
+	mi := &file_habit_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTargetRequest) ProtoMessage() {}
+
+func (x *SetTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTargetRequest.ProtoReflect.Descriptor instead.
+func (*SetTargetRequest) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetTargetRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *SetTargetRequest) GetTargetValue() float64 {
+	if x != nil {
+		return x.TargetValue
+	}
+	return 0
+}
+
+func (x *SetTargetRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type SetTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTargetResponse) Reset() {
+	*x = SetTargetResponse{}
+	mi := &file_habit_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTargetResponse) ProtoMessage() {}
+
+func (x *SetTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTargetResponse.ProtoReflect.Descriptor instead.
+func (*SetTargetResponse) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetTargetResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+type SetDailyCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	DailyCount    int32                  `protobuf:"varint,2,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDailyCountRequest) Reset() {
+	*x = SetDailyCountRequest{}
+	mi := &file_habit_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDailyCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDailyCountRequest) ProtoMessage() {}
+
+func (x *SetDailyCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDailyCountRequest.ProtoReflect.Descriptor instead.
+func (*SetDailyCountRequest) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetDailyCountRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *SetDailyCountRequest) GetDailyCount() int32 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+type SetDailyCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDailyCountResponse) Reset() {
+	*x = SetDailyCountResponse{}
+	mi := &file_habit_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDailyCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDailyCountResponse) ProtoMessage() {}
+
+func (x *SetDailyCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDailyCountResponse.ProtoReflect.Descriptor instead.
+func (*SetDailyCountResponse) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetDailyCountResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+type IsScheduledTodayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsScheduledTodayRequest) Reset() {
+	*x = IsScheduledTodayRequest{}
+	mi := &file_habit_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsScheduledTodayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsScheduledTodayRequest) ProtoMessage() {}
+
+func (x *IsScheduledTodayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsScheduledTodayRequest.ProtoReflect.Descriptor instead.
+func (*IsScheduledTodayRequest) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IsScheduledTodayRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+type IsScheduledTodayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scheduled     bool                   `protobuf:"varint,1,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsScheduledTodayResponse) Reset() {
+	*x = IsScheduledTodayResponse{}
+	mi := &file_habit_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsScheduledTodayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsScheduledTodayResponse) ProtoMessage() {}
+
+func (x *IsScheduledTodayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsScheduledTodayResponse.ProtoReflect.Descriptor instead.
+func (*IsScheduledTodayResponse) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *IsScheduledTodayResponse) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
+type RecomputeStreaksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"` // если задан - пересчитывается только эта привычка
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // иначе - все привычки пользователя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeStreaksRequest) Reset() {
+	*x = RecomputeStreaksRequest{}
+	mi := &file_habit_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeStreaksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeStreaksRequest) ProtoMessage() {}
+
+func (x *RecomputeStreaksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeStreaksRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeStreaksRequest) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecomputeStreaksRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *RecomputeStreaksRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RecomputeStreaksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habits        []*Habit               `protobuf:"bytes,1,rep,name=habits,proto3" json:"habits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeStreaksResponse) Reset() {
+	*x = RecomputeStreaksResponse{}
+	mi := &file_habit_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeStreaksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeStreaksResponse) ProtoMessage() {}
+
+func (x *RecomputeStreaksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeStreaksResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeStreaksResponse) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecomputeStreaksResponse) GetHabits() []*Habit {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+type SetGraduationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HabitId        int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	StreakDays     int32                  `protobuf:"varint,2,opt,name=streak_days,json=streakDays,proto3" json:"streak_days,omitempty"`             // стрик для выработки, 0 - критерий не используется
+	CompletionRate int32                  `protobuf:"varint,3,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"` // процент выполнения 1-100, 0 - критерий не используется
+	Weeks          int32                  `protobuf:"varint,4,opt,name=weeks,proto3" json:"weeks,omitempty"`                                         // за сколько последних недель считается completion_rate
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetGraduationRequest) Reset() {
+	*x = SetGraduationRequest{}
+	mi := &file_habit_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGraduationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGraduationRequest) ProtoMessage() {}
+
+func (x *SetGraduationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGraduationRequest.ProtoReflect.Descriptor instead.
+func (*SetGraduationRequest) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetGraduationRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *SetGraduationRequest) GetStreakDays() int32 {
+	if x != nil {
+		return x.StreakDays
+	}
+	return 0
+}
+
+func (x *SetGraduationRequest) GetCompletionRate() int32 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+func (x *SetGraduationRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+type SetGraduationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGraduationResponse) Reset() {
+	*x = SetGraduationResponse{}
+	mi := &file_habit_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGraduationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGraduationResponse) ProtoMessage() {}
+
+func (x *SetGraduationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGraduationResponse.ProtoReflect.Descriptor instead.
+func (*SetGraduationResponse) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetGraduationResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+type GetFormedHabitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFormedHabitsRequest) Reset() {
+	*x = GetFormedHabitsRequest{}
+	mi := &file_habit_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFormedHabitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFormedHabitsRequest) ProtoMessage() {}
+
+func (x *GetFormedHabitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFormedHabitsRequest.ProtoReflect.Descriptor instead.
+func (*GetFormedHabitsRequest) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetFormedHabitsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetFormedHabitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habits        []*Habit               `protobuf:"bytes,1,rep,name=habits,proto3" json:"habits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFormedHabitsResponse) Reset() {
+	*x = GetFormedHabitsResponse{}
+	mi := &file_habit_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFormedHabitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFormedHabitsResponse) ProtoMessage() {}
+
+func (x *GetFormedHabitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFormedHabitsResponse.ProtoReflect.Descriptor instead.
+func (*GetFormedHabitsResponse) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetFormedHabitsResponse) GetHabits() []*Habit {
+	if x != nil {
+		return x.Habits
+	}
+	return nil
+}
+
+type SetChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	StartsOn      string                 `protobuf:"bytes,2,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"` // ISO 8601 date, пусто - без даты начала
+	EndsOn        string                 `protobuf:"bytes,3,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`       // ISO 8601 date, пусто - без даты окончания
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChallengeRequest) Reset() {
+	*x = SetChallengeRequest{}
+	mi := &file_habit_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChallengeRequest) ProtoMessage() {}
+
+func (x *SetChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChallengeRequest.ProtoReflect.Descriptor instead.
+func (*SetChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetChallengeRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *SetChallengeRequest) GetStartsOn() string {
+	if x != nil {
+		return x.StartsOn
+	}
+	return ""
+}
+
+func (x *SetChallengeRequest) GetEndsOn() string {
+	if x != nil {
+		return x.EndsOn
+	}
+	return ""
+}
+
+type SetChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Habit         *Habit                 `protobuf:"bytes,1,opt,name=habit,proto3" json:"habit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChallengeResponse) Reset() {
+	*x = SetChallengeResponse{}
+	mi := &file_habit_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChallengeResponse) ProtoMessage() {}
+
+func (x *SetChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChallengeResponse.ProtoReflect.Descriptor instead.
+func (*SetChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetChallengeResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+type GetChallengeReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChallengeReportRequest) Reset() {
+	*x = GetChallengeReportRequest{}
+	mi := &file_habit_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeReportRequest) ProtoMessage() {}
+
+func (x *GetChallengeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetChallengeReportRequest) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetChallengeReportRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+type GetChallengeReportResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HabitId        int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	StartsOn       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_on,json=startsOn,proto3" json:"starts_on,omitempty"`
+	EndsOn         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_on,json=endsOn,proto3" json:"ends_on,omitempty"`
+	Finished       bool                   `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`                       // false - отчет промежуточный, по сегодняшний день
+	DaysDone       int32                  `protobuf:"varint,5,opt,name=days_done,json=daysDone,proto3" json:"days_done,omitempty"`       // у привычки-отказа - дни без срыва
+	DaysMissed     int32                  `protobuf:"varint,6,opt,name=days_missed,json=daysMissed,proto3" json:"days_missed,omitempty"` // у квоты - периоды с невыполненной квотой, у отказа - дни срывов
+	DaysSkipped    int32                  `protobuf:"varint,7,opt,name=days_skipped,json=daysSkipped,proto3" json:"days_skipped,omitempty"`
+	CompletionRate float64                `protobuf:"fixed64,8,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetChallengeReportResponse) Reset() {
+	*x = GetChallengeReportResponse{}
+	mi := &file_habit_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChallengeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChallengeReportResponse) ProtoMessage() {}
+
+func (x *GetChallengeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_habit_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChallengeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetChallengeReportResponse) Descriptor() ([]byte, []int) {
+	return file_habit_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetChallengeReportResponse) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *GetChallengeReportResponse) GetStartsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsOn
+	}
+	return nil
+}
+
+func (x *GetChallengeReportResponse) GetEndsOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsOn
+	}
+	return nil
+}
+
+func (x *GetChallengeReportResponse) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *GetChallengeReportResponse) GetDaysDone() int32 {
+	if x != nil {
+		return x.DaysDone
+	}
+	return 0
+}
+
+func (x *GetChallengeReportResponse) GetDaysMissed() int32 {
+	if x != nil {
+		return x.DaysMissed
+	}
+	return 0
+}
+
+func (x *GetChallengeReportResponse) GetDaysSkipped() int32 {
+	if x != nil {
+		return x.DaysSkipped
+	}
+	return 0
+}
+
+func (x *GetChallengeReportResponse) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+var File_habit_service_proto protoreflect.FileDescriptor
+
+const file_habit_service_proto_rawDesc = "" +
+	"\n" +
+	"\x13habit_service.proto\x12\x0ehobbits.api.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x04\n" +
+	"\x12CreateHabitRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tfrequency\x18\x03 \x01(\tR\tfrequency\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04goal\x18\x05 \x01(\tR\x04goal\x12\x1f\n" +
+	"\vweekly_days\x18\x06 \x01(\tR\n" +
+	"weeklyDays\x12!\n" +
+	"\fmonthly_days\x18\a \x01(\tR\vmonthlyDays\x12#\n" +
+	"\rinterval_days\x18\b \x01(\x05R\fintervalDays\x12\x1f\n" +
+	"\vanchor_date\x18\t \x01(\tR\n" +
+	"anchorDate\x12!\n" +
+	"\fquota_target\x18\n" +
+	" \x01(\x05R\vquotaTarget\x12!\n" +
+	"\fquota_period\x18\v \x01(\tR\vquotaPeriod\x12\x14\n" +
+	"\x05rrule\x18\f \x01(\tR\x05rrule\x12\x1f\n" +
+	"\vrrule_start\x18\r \x01(\tR\n" +
+	"rruleStart\x12!\n" +
+	"\ftarget_value\x18\x0e \x01(\x01R\vtargetValue\x12\x12\n" +
+	"\x04unit\x18\x0f \x01(\tR\x04unit\x12\x1f\n" +
+	"\vdaily_count\x18\x10 \x01(\x05R\n" +
+	"dailyCount\x12\x1b\n" +
+	"\tstarts_on\x18\x11 \x01(\tR\bstartsOn\x12\x17\n" +
+	"\aends_on\x18\x12 \x01(\tR\x06endsOn\"B\n" +
+	"\x13CreateHabitResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"!\n" +
+	"\x0fGetHabitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"?\n" +
+	"\x10GetHabitResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"/\n" +
+	"\x14GetUserHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"F\n" +
+	"\x15GetUserHabitsResponse\x12-\n" +
+	"\x06habits\x18\x01 \x03(\v2\x15.hobbits.api.v1.HabitR\x06habits\"1\n" +
+	"\x16GetActiveHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"H\n" +
+	"\x17GetActiveHabitsResponse\x12-\n" +
+	"\x06habits\x18\x01 \x03(\v2\x15.hobbits.api.v1.HabitR\x06habits\"n\n" +
+	"\x12UpdateHabitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04goal\x18\x04 \x01(\tR\x04goal\"B\n" +
+	"\x13UpdateHabitResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"$\n" +
+	"\x12DeleteHabitRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"/\n" +
+	"\x13DeleteHabitResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
+	"\x14SetWeeklyDaysRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x12\n" +
+	"\x04days\x18\x02 \x03(\x05R\x04days\"D\n" +
+	"\x15SetWeeklyDaysResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"}\n" +
+	"\x15SetMonthlyDaysRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x12\n" +
+	"\x04days\x18\x02 \x03(\x05R\x04days\x12\x19\n" +
+	"\blast_day\x18\x03 \x01(\bR\alastDay\x12\x1a\n" +
+	"\bweekdays\x18\x04 \x03(\tR\bweekdays\"E\n" +
+	"\x16SetMonthlyDaysResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"j\n" +
+	"\x18SetRecurrenceRuleRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x14\n" +
+	"\x05rrule\x18\x02 \x01(\tR\x05rrule\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\"H\n" +
+	"\x19SetRecurrenceRuleResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"d\n" +
+	"\x10SetTargetRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12!\n" +
+	"\ftarget_value\x18\x02 \x01(\x01R\vtargetValue\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\"@\n" +
+	"\x11SetTargetResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"R\n" +
+	"\x14SetDailyCountRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x1f\n" +
+	"\vdaily_count\x18\x02 \x01(\x05R\n" +
+	"dailyCount\"D\n" +
+	"\x15SetDailyCountResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"4\n" +
+	"\x17IsScheduledTodayRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\"8\n" +
+	"\x18IsScheduledTodayResponse\x12\x1c\n" +
+	"\tscheduled\x18\x01 \x01(\bR\tscheduled\"M\n" +
+	"\x17RecomputeStreaksRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"I\n" +
+	"\x18RecomputeStreaksResponse\x12-\n" +
+	"\x06habits\x18\x01 \x03(\v2\x15.hobbits.api.v1.HabitR\x06habits\"\x91\x01\n" +
+	"\x14SetGraduationRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x1f\n" +
+	"\vstreak_days\x18\x02 \x01(\x05R\n" +
+	"streakDays\x12'\n" +
+	"\x0fcompletion_rate\x18\x03 \x01(\x05R\x0ecompletionRate\x12\x14\n" +
+	"\x05weeks\x18\x04 \x01(\x05R\x05weeks\"D\n" +
+	"\x15SetGraduationResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"1\n" +
+	"\x16GetFormedHabitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"H\n" +
+	"\x17GetFormedHabitsResponse\x12-\n" +
+	"\x06habits\x18\x01 \x03(\v2\x15.hobbits.api.v1.HabitR\x06habits\"f\n" +
+	"\x13SetChallengeRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x1b\n" +
+	"\tstarts_on\x18\x02 \x01(\tR\bstartsOn\x12\x17\n" +
+	"\aends_on\x18\x03 \x01(\tR\x06endsOn\"C\n" +
+	"\x14SetChallengeResponse\x12+\n" +
+	"\x05habit\x18\x01 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"6\n" +
+	"\x19GetChallengeReportRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\"\xcb\x02\n" +
+	"\x1aGetChallengeReportResponse\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x127\n" +
+	"\tstarts_on\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsOn\x123\n" +
+	"\aends_on\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsOn\x12\x1a\n" +
+	"\bfinished\x18\x04 \x01(\bR\bfinished\x12\x1b\n" +
+	"\tdays_done\x18\x05 \x01(\x05R\bdaysDone\x12\x1f\n" +
+	"\vdays_missed\x18\x06 \x01(\x05R\n" +
+	"daysMissed\x12!\n" +
+	"\fdays_skipped\x18\a \x01(\x05R\vdaysSkipped\x12'\n" +
+	"\x0fcompletion_rate\x18\b \x01(\x01R\x0ecompletionRate2\xd8\f\n" +
 	"\fHabitService\x12V\n" +
 	"\vCreateHabit\x12\".hobbits.api.v1.CreateHabitRequest\x1a#.hobbits.api.v1.CreateHabitResponse\x12M\n" +
 	"\bGetHabit\x12\x1f.hobbits.api.v1.GetHabitRequest\x1a .hobbits.api.v1.GetHabitResponse\x12\\\n" +
@@ -962,8 +1986,16 @@ const file_habit_service_proto_rawDesc = "" +
 	"\vUpdateHabit\x12\".hobbits.api.v1.UpdateHabitRequest\x1a#.hobbits.api.v1.UpdateHabitResponse\x12V\n" +
 	"\vDeleteHabit\x12\".hobbits.api.v1.DeleteHabitRequest\x1a#.hobbits.api.v1.DeleteHabitResponse\x12\\\n" +
 	"\rSetWeeklyDays\x12$.hobbits.api.v1.SetWeeklyDaysRequest\x1a%.hobbits.api.v1.SetWeeklyDaysResponse\x12_\n" +
-	"\x0eSetMonthlyDays\x12%.hobbits.api.v1.SetMonthlyDaysRequest\x1a&.hobbits.api.v1.SetMonthlyDaysResponse\x12e\n" +
-	"\x10IsScheduledToday\x12'.hobbits.api.v1.IsScheduledTodayRequest\x1a(.hobbits.api.v1.IsScheduledTodayResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
+	"\x0eSetMonthlyDays\x12%.hobbits.api.v1.SetMonthlyDaysRequest\x1a&.hobbits.api.v1.SetMonthlyDaysResponse\x12h\n" +
+	"\x11SetRecurrenceRule\x12(.hobbits.api.v1.SetRecurrenceRuleRequest\x1a).hobbits.api.v1.SetRecurrenceRuleResponse\x12P\n" +
+	"\tSetTarget\x12 .hobbits.api.v1.SetTargetRequest\x1a!.hobbits.api.v1.SetTargetResponse\x12\\\n" +
+	"\rSetDailyCount\x12$.hobbits.api.v1.SetDailyCountRequest\x1a%.hobbits.api.v1.SetDailyCountResponse\x12e\n" +
+	"\x10IsScheduledToday\x12'.hobbits.api.v1.IsScheduledTodayRequest\x1a(.hobbits.api.v1.IsScheduledTodayResponse\x12e\n" +
+	"\x10RecomputeStreaks\x12'.hobbits.api.v1.RecomputeStreaksRequest\x1a(.hobbits.api.v1.RecomputeStreaksResponse\x12\\\n" +
+	"\rSetGraduation\x12$.hobbits.api.v1.SetGraduationRequest\x1a%.hobbits.api.v1.SetGraduationResponse\x12b\n" +
+	"\x0fGetFormedHabits\x12&.hobbits.api.v1.GetFormedHabitsRequest\x1a'.hobbits.api.v1.GetFormedHabitsResponse\x12Y\n" +
+	"\fSetChallenge\x12#.hobbits.api.v1.SetChallengeRequest\x1a$.hobbits.api.v1.SetChallengeResponse\x12k\n" +
+	"\x12GetChallengeReport\x12).hobbits.api.v1.GetChallengeReportRequest\x1a*.hobbits.api.v1.GetChallengeReportResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
 
 var (
 	file_habit_service_proto_rawDescOnce sync.Once
@@ -977,59 +2009,101 @@ func file_habit_service_proto_rawDescGZIP() []byte {
 	return file_habit_service_proto_rawDescData
 }
 
-var file_habit_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_habit_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_habit_service_proto_goTypes = []any{
-	(*CreateHabitRequest)(nil),       // 0: hobbits.api.v1.CreateHabitRequest
-	(*CreateHabitResponse)(nil),      // 1: hobbits.api.v1.CreateHabitResponse
-	(*GetHabitRequest)(nil),          // 2: hobbits.api.v1.GetHabitRequest
-	(*GetHabitResponse)(nil),         // 3: hobbits.api.v1.GetHabitResponse
-	(*GetUserHabitsRequest)(nil),     // 4: hobbits.api.v1.GetUserHabitsRequest
-	(*GetUserHabitsResponse)(nil),    // 5: hobbits.api.v1.GetUserHabitsResponse
-	(*GetActiveHabitsRequest)(nil),   // 6: hobbits.api.v1.GetActiveHabitsRequest
-	(*GetActiveHabitsResponse)(nil),  // 7: hobbits.api.v1.GetActiveHabitsResponse
-	(*UpdateHabitRequest)(nil),       // 8: hobbits.api.v1.UpdateHabitRequest
-	(*UpdateHabitResponse)(nil),      // 9: hobbits.api.v1.UpdateHabitResponse
-	(*DeleteHabitRequest)(nil),       // 10: hobbits.api.v1.DeleteHabitRequest
-	(*DeleteHabitResponse)(nil),      // 11: hobbits.api.v1.DeleteHabitResponse
-	(*SetWeeklyDaysRequest)(nil),     // 12: hobbits.api.v1.SetWeeklyDaysRequest
-	(*SetWeeklyDaysResponse)(nil),    // 13: hobbits.api.v1.SetWeeklyDaysResponse
-	(*SetMonthlyDaysRequest)(nil),    // 14: hobbits.api.v1.SetMonthlyDaysRequest
-	(*SetMonthlyDaysResponse)(nil),   // 15: hobbits.api.v1.SetMonthlyDaysResponse
-	(*IsScheduledTodayRequest)(nil),  // 16: hobbits.api.v1.IsScheduledTodayRequest
-	(*IsScheduledTodayResponse)(nil), // 17: hobbits.api.v1.IsScheduledTodayResponse
-	(*Habit)(nil),                    // 18: hobbits.api.v1.Habit
+	(*CreateHabitRequest)(nil),         // 0: hobbits.api.v1.CreateHabitRequest
+	(*CreateHabitResponse)(nil),        // 1: hobbits.api.v1.CreateHabitResponse
+	(*GetHabitRequest)(nil),            // 2: hobbits.api.v1.GetHabitRequest
+	(*GetHabitResponse)(nil),           // 3: hobbits.api.v1.GetHabitResponse
+	(*GetUserHabitsRequest)(nil),       // 4: hobbits.api.v1.GetUserHabitsRequest
+	(*GetUserHabitsResponse)(nil),      // 5: hobbits.api.v1.GetUserHabitsResponse
+	(*GetActiveHabitsRequest)(nil),     // 6: hobbits.api.v1.GetActiveHabitsRequest
+	(*GetActiveHabitsResponse)(nil),    // 7: hobbits.api.v1.GetActiveHabitsResponse
+	(*UpdateHabitRequest)(nil),         // 8: hobbits.api.v1.UpdateHabitRequest
+	(*UpdateHabitResponse)(nil),        // 9: hobbits.api.v1.UpdateHabitResponse
+	(*DeleteHabitRequest)(nil),         // 10: hobbits.api.v1.DeleteHabitRequest
+	(*DeleteHabitResponse)(nil),        // 11: hobbits.api.v1.DeleteHabitResponse
+	(*SetWeeklyDaysRequest)(nil),       // 12: hobbits.api.v1.SetWeeklyDaysRequest
+	(*SetWeeklyDaysResponse)(nil),      // 13: hobbits.api.v1.SetWeeklyDaysResponse
+	(*SetMonthlyDaysRequest)(nil),      // 14: hobbits.api.v1.SetMonthlyDaysRequest
+	(*SetMonthlyDaysResponse)(nil),     // 15: hobbits.api.v1.SetMonthlyDaysResponse
+	(*SetRecurrenceRuleRequest)(nil),   // 16: hobbits.api.v1.SetRecurrenceRuleRequest
+	(*SetRecurrenceRuleResponse)(nil),  // 17: hobbits.api.v1.SetRecurrenceRuleResponse
+	(*SetTargetRequest)(nil),           // 18: hobbits.api.v1.SetTargetRequest
+	(*SetTargetResponse)(nil),          // 19: hobbits.api.v1.SetTargetResponse
+	(*SetDailyCountRequest)(nil),       // 20: hobbits.api.v1.SetDailyCountRequest
+	(*SetDailyCountResponse)(nil),      // 21: hobbits.api.v1.SetDailyCountResponse
+	(*IsScheduledTodayRequest)(nil),    // 22: hobbits.api.v1.IsScheduledTodayRequest
+	(*IsScheduledTodayResponse)(nil),   // 23: hobbits.api.v1.IsScheduledTodayResponse
+	(*RecomputeStreaksRequest)(nil),    // 24: hobbits.api.v1.RecomputeStreaksRequest
+	(*RecomputeStreaksResponse)(nil),   // 25: hobbits.api.v1.RecomputeStreaksResponse
+	(*SetGraduationRequest)(nil),       // 26: hobbits.api.v1.SetGraduationRequest
+	(*SetGraduationResponse)(nil),      // 27: hobbits.api.v1.SetGraduationResponse
+	(*GetFormedHabitsRequest)(nil),     // 28: hobbits.api.v1.GetFormedHabitsRequest
+	(*GetFormedHabitsResponse)(nil),    // 29: hobbits.api.v1.GetFormedHabitsResponse
+	(*SetChallengeRequest)(nil),        // 30: hobbits.api.v1.SetChallengeRequest
+	(*SetChallengeResponse)(nil),       // 31: hobbits.api.v1.SetChallengeResponse
+	(*GetChallengeReportRequest)(nil),  // 32: hobbits.api.v1.GetChallengeReportRequest
+	(*GetChallengeReportResponse)(nil), // 33: hobbits.api.v1.GetChallengeReportResponse
+	(*Habit)(nil),                      // 34: hobbits.api.v1.Habit
+	(*timestamppb.Timestamp)(nil),      // 35: google.protobuf.Timestamp
 }
 var file_habit_service_proto_depIdxs = []int32{
-	18, // 0: hobbits.api.v1.CreateHabitResponse.habit:type_name -> hobbits.api.v1.Habit
-	18, // 1: hobbits.api.v1.GetHabitResponse.habit:type_name -> hobbits.api.v1.Habit
-	18, // 2: hobbits.api.v1.GetUserHabitsResponse.habits:type_name -> hobbits.api.v1.Habit
-	18, // 3: hobbits.api.v1.GetActiveHabitsResponse.habits:type_name -> hobbits.api.v1.Habit
-	18, // 4: hobbits.api.v1.UpdateHabitResponse.habit:type_name -> hobbits.api.v1.Habit
-	18, // 5: hobbits.api.v1.SetWeeklyDaysResponse.habit:type_name -> hobbits.api.v1.Habit
-	18, // 6: hobbits.api.v1.SetMonthlyDaysResponse.habit:type_name -> hobbits.api.v1.Habit
-	0,  // 7: hobbits.api.v1.HabitService.CreateHabit:input_type -> hobbits.api.v1.CreateHabitRequest
-	2,  // 8: hobbits.api.v1.HabitService.GetHabit:input_type -> hobbits.api.v1.GetHabitRequest
-	4,  // 9: hobbits.api.v1.HabitService.GetUserHabits:input_type -> hobbits.api.v1.GetUserHabitsRequest
-	6,  // 10: hobbits.api.v1.HabitService.GetActiveHabits:input_type -> hobbits.api.v1.GetActiveHabitsRequest
-	8,  // 11: hobbits.api.v1.HabitService.UpdateHabit:input_type -> hobbits.api.v1.UpdateHabitRequest
-	10, // 12: hobbits.api.v1.HabitService.DeleteHabit:input_type -> hobbits.api.v1.DeleteHabitRequest
-	12, // 13: hobbits.api.v1.HabitService.SetWeeklyDays:input_type -> hobbits.api.v1.SetWeeklyDaysRequest
-	14, // 14: hobbits.api.v1.HabitService.SetMonthlyDays:input_type -> hobbits.api.v1.SetMonthlyDaysRequest
-	16, // 15: hobbits.api.v1.HabitService.IsScheduledToday:input_type -> hobbits.api.v1.IsScheduledTodayRequest
-	1,  // 16: hobbits.api.v1.HabitService.CreateHabit:output_type -> hobbits.api.v1.CreateHabitResponse
-	3,  // 17: hobbits.api.v1.HabitService.GetHabit:output_type -> hobbits.api.v1.GetHabitResponse
-	5,  // 18: hobbits.api.v1.HabitService.GetUserHabits:output_type -> hobbits.api.v1.GetUserHabitsResponse
-	7,  // 19: hobbits.api.v1.HabitService.GetActiveHabits:output_type -> hobbits.api.v1.GetActiveHabitsResponse
-	9,  // 20: hobbits.api.v1.HabitService.UpdateHabit:output_type -> hobbits.api.v1.UpdateHabitResponse
-	11, // 21: hobbits.api.v1.HabitService.DeleteHabit:output_type -> hobbits.api.v1.DeleteHabitResponse
-	13, // 22: hobbits.api.v1.HabitService.SetWeeklyDays:output_type -> hobbits.api.v1.SetWeeklyDaysResponse
-	15, // 23: hobbits.api.v1.HabitService.SetMonthlyDays:output_type -> hobbits.api.v1.SetMonthlyDaysResponse
-	17, // 24: hobbits.api.v1.HabitService.IsScheduledToday:output_type -> hobbits.api.v1.IsScheduledTodayResponse
-	16, // [16:25] is the sub-list for method output_type
-	7,  // [7:16] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	34, // 0: hobbits.api.v1.CreateHabitResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 1: hobbits.api.v1.GetHabitResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 2: hobbits.api.v1.GetUserHabitsResponse.habits:type_name -> hobbits.api.v1.Habit
+	34, // 3: hobbits.api.v1.GetActiveHabitsResponse.habits:type_name -> hobbits.api.v1.Habit
+	34, // 4: hobbits.api.v1.UpdateHabitResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 5: hobbits.api.v1.SetWeeklyDaysResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 6: hobbits.api.v1.SetMonthlyDaysResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 7: hobbits.api.v1.SetRecurrenceRuleResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 8: hobbits.api.v1.SetTargetResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 9: hobbits.api.v1.SetDailyCountResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 10: hobbits.api.v1.RecomputeStreaksResponse.habits:type_name -> hobbits.api.v1.Habit
+	34, // 11: hobbits.api.v1.SetGraduationResponse.habit:type_name -> hobbits.api.v1.Habit
+	34, // 12: hobbits.api.v1.GetFormedHabitsResponse.habits:type_name -> hobbits.api.v1.Habit
+	34, // 13: hobbits.api.v1.SetChallengeResponse.habit:type_name -> hobbits.api.v1.Habit
+	35, // 14: hobbits.api.v1.GetChallengeReportResponse.starts_on:type_name -> google.protobuf.Timestamp
+	35, // 15: hobbits.api.v1.GetChallengeReportResponse.ends_on:type_name -> google.protobuf.Timestamp
+	0,  // 16: hobbits.api.v1.HabitService.CreateHabit:input_type -> hobbits.api.v1.CreateHabitRequest
+	2,  // 17: hobbits.api.v1.HabitService.GetHabit:input_type -> hobbits.api.v1.GetHabitRequest
+	4,  // 18: hobbits.api.v1.HabitService.GetUserHabits:input_type -> hobbits.api.v1.GetUserHabitsRequest
+	6,  // 19: hobbits.api.v1.HabitService.GetActiveHabits:input_type -> hobbits.api.v1.GetActiveHabitsRequest
+	8,  // 20: hobbits.api.v1.HabitService.UpdateHabit:input_type -> hobbits.api.v1.UpdateHabitRequest
+	10, // 21: hobbits.api.v1.HabitService.DeleteHabit:input_type -> hobbits.api.v1.DeleteHabitRequest
+	12, // 22: hobbits.api.v1.HabitService.SetWeeklyDays:input_type -> hobbits.api.v1.SetWeeklyDaysRequest
+	14, // 23: hobbits.api.v1.HabitService.SetMonthlyDays:input_type -> hobbits.api.v1.SetMonthlyDaysRequest
+	16, // 24: hobbits.api.v1.HabitService.SetRecurrenceRule:input_type -> hobbits.api.v1.SetRecurrenceRuleRequest
+	18, // 25: hobbits.api.v1.HabitService.SetTarget:input_type -> hobbits.api.v1.SetTargetRequest
+	20, // 26: hobbits.api.v1.HabitService.SetDailyCount:input_type -> hobbits.api.v1.SetDailyCountRequest
+	22, // 27: hobbits.api.v1.HabitService.IsScheduledToday:input_type -> hobbits.api.v1.IsScheduledTodayRequest
+	24, // 28: hobbits.api.v1.HabitService.RecomputeStreaks:input_type -> hobbits.api.v1.RecomputeStreaksRequest
+	26, // 29: hobbits.api.v1.HabitService.SetGraduation:input_type -> hobbits.api.v1.SetGraduationRequest
+	28, // 30: hobbits.api.v1.HabitService.GetFormedHabits:input_type -> hobbits.api.v1.GetFormedHabitsRequest
+	30, // 31: hobbits.api.v1.HabitService.SetChallenge:input_type -> hobbits.api.v1.SetChallengeRequest
+	32, // 32: hobbits.api.v1.HabitService.GetChallengeReport:input_type -> hobbits.api.v1.GetChallengeReportRequest
+	1,  // 33: hobbits.api.v1.HabitService.CreateHabit:output_type -> hobbits.api.v1.CreateHabitResponse
+	3,  // 34: hobbits.api.v1.HabitService.GetHabit:output_type -> hobbits.api.v1.GetHabitResponse
+	5,  // 35: hobbits.api.v1.HabitService.GetUserHabits:output_type -> hobbits.api.v1.GetUserHabitsResponse
+	7,  // 36: hobbits.api.v1.HabitService.GetActiveHabits:output_type -> hobbits.api.v1.GetActiveHabitsResponse
+	9,  // 37: hobbits.api.v1.HabitService.UpdateHabit:output_type -> hobbits.api.v1.UpdateHabitResponse
+	11, // 38: hobbits.api.v1.HabitService.DeleteHabit:output_type -> hobbits.api.v1.DeleteHabitResponse
+	13, // 39: hobbits.api.v1.HabitService.SetWeeklyDays:output_type -> hobbits.api.v1.SetWeeklyDaysResponse
+	15, // 40: hobbits.api.v1.HabitService.SetMonthlyDays:output_type -> hobbits.api.v1.SetMonthlyDaysResponse
+	17, // 41: hobbits.api.v1.HabitService.SetRecurrenceRule:output_type -> hobbits.api.v1.SetRecurrenceRuleResponse
+	19, // 42: hobbits.api.v1.HabitService.SetTarget:output_type -> hobbits.api.v1.SetTargetResponse
+	21, // 43: hobbits.api.v1.HabitService.SetDailyCount:output_type -> hobbits.api.v1.SetDailyCountResponse
+	23, // 44: hobbits.api.v1.HabitService.IsScheduledToday:output_type -> hobbits.api.v1.IsScheduledTodayResponse
+	25, // 45: hobbits.api.v1.HabitService.RecomputeStreaks:output_type -> hobbits.api.v1.RecomputeStreaksResponse
+	27, // 46: hobbits.api.v1.HabitService.SetGraduation:output_type -> hobbits.api.v1.SetGraduationResponse
+	29, // 47: hobbits.api.v1.HabitService.GetFormedHabits:output_type -> hobbits.api.v1.GetFormedHabitsResponse
+	31, // 48: hobbits.api.v1.HabitService.SetChallenge:output_type -> hobbits.api.v1.SetChallengeResponse
+	33, // 49: hobbits.api.v1.HabitService.GetChallengeReport:output_type -> hobbits.api.v1.GetChallengeReportResponse
+	33, // [33:50] is the sub-list for method output_type
+	16, // [16:33] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_habit_service_proto_init() }
@@ -1044,7 +2118,7 @@ func file_habit_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_habit_service_proto_rawDesc), len(file_habit_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,15 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HabitService_CreateHabit_FullMethodName      = "/hobbits.api.v1.HabitService/CreateHabit"
-	HabitService_GetHabit_FullMethodName         = "/hobbits.api.v1.HabitService/GetHabit"
-	HabitService_GetUserHabits_FullMethodName    = "/hobbits.api.v1.HabitService/GetUserHabits"
-	HabitService_GetActiveHabits_FullMethodName  = "/hobbits.api.v1.HabitService/GetActiveHabits"
-	HabitService_UpdateHabit_FullMethodName      = "/hobbits.api.v1.HabitService/UpdateHabit"
-	HabitService_DeleteHabit_FullMethodName      = "/hobbits.api.v1.HabitService/DeleteHabit"
-	HabitService_SetWeeklyDays_FullMethodName    = "/hobbits.api.v1.HabitService/SetWeeklyDays"
-	HabitService_SetMonthlyDays_FullMethodName   = "/hobbits.api.v1.HabitService/SetMonthlyDays"
-	HabitService_IsScheduledToday_FullMethodName = "/hobbits.api.v1.HabitService/IsScheduledToday"
+	HabitService_CreateHabit_FullMethodName        = "/hobbits.api.v1.HabitService/CreateHabit"
+	HabitService_GetHabit_FullMethodName           = "/hobbits.api.v1.HabitService/GetHabit"
+	HabitService_GetUserHabits_FullMethodName      = "/hobbits.api.v1.HabitService/GetUserHabits"
+	HabitService_GetActiveHabits_FullMethodName    = "/hobbits.api.v1.HabitService/GetActiveHabits"
+	HabitService_UpdateHabit_FullMethodName        = "/hobbits.api.v1.HabitService/UpdateHabit"
+	HabitService_DeleteHabit_FullMethodName        = "/hobbits.api.v1.HabitService/DeleteHabit"
+	HabitService_SetWeeklyDays_FullMethodName      = "/hobbits.api.v1.HabitService/SetWeeklyDays"
+	HabitService_SetMonthlyDays_FullMethodName     = "/hobbits.api.v1.HabitService/SetMonthlyDays"
+	HabitService_SetRecurrenceRule_FullMethodName  = "/hobbits.api.v1.HabitService/SetRecurrenceRule"
+	HabitService_SetTarget_FullMethodName          = "/hobbits.api.v1.HabitService/SetTarget"
+	HabitService_SetDailyCount_FullMethodName      = "/hobbits.api.v1.HabitService/SetDailyCount"
+	HabitService_IsScheduledToday_FullMethodName   = "/hobbits.api.v1.HabitService/IsScheduledToday"
+	HabitService_RecomputeStreaks_FullMethodName   = "/hobbits.api.v1.HabitService/RecomputeStreaks"
+	HabitService_SetGraduation_FullMethodName      = "/hobbits.api.v1.HabitService/SetGraduation"
+	HabitService_GetFormedHabits_FullMethodName    = "/hobbits.api.v1.HabitService/GetFormedHabits"
+	HabitService_SetChallenge_FullMethodName       = "/hobbits.api.v1.HabitService/SetChallenge"
+	HabitService_GetChallengeReport_FullMethodName = "/hobbits.api.v1.HabitService/GetChallengeReport"
 )
 
 // HabitServiceClient is the client API for HabitService service.
@@ -52,8 +60,24 @@ type HabitServiceClient interface {
 	SetWeeklyDays(ctx context.Context, in *SetWeeklyDaysRequest, opts ...grpc.CallOption) (*SetWeeklyDaysResponse, error)
 	// SetMonthlyDays устанавливает дни месяца для ежемесячной привычки
 	SetMonthlyDays(ctx context.Context, in *SetMonthlyDaysRequest, opts ...grpc.CallOption) (*SetMonthlyDaysResponse, error)
+	// SetRecurrenceRule задает правило повторения RFC 5545 (RRULE) вместо frequency
+	SetRecurrenceRule(ctx context.Context, in *SetRecurrenceRuleRequest, opts ...grpc.CallOption) (*SetRecurrenceRuleResponse, error)
+	// SetTarget задает дневную цель количественной привычки
+	SetTarget(ctx context.Context, in *SetTargetRequest, opts ...grpc.CallOption) (*SetTargetResponse, error)
+	// SetDailyCount задает, сколько раз за день нужно выполнить привычку
+	SetDailyCount(ctx context.Context, in *SetDailyCountRequest, opts ...grpc.CallOption) (*SetDailyCountResponse, error)
 	// IsScheduledToday проверяет, нужно ли подтверждение сегодня
 	IsScheduledToday(ctx context.Context, in *IsScheduledTodayRequest, opts ...grpc.CallOption) (*IsScheduledTodayResponse, error)
+	// RecomputeStreaks пересчитывает стрики по истории логов: одной привычки или всех привычек пользователя
+	RecomputeStreaks(ctx context.Context, in *RecomputeStreaksRequest, opts ...grpc.CallOption) (*RecomputeStreaksResponse, error)
+	// SetGraduation задает критерии, по которым привычка считается выработанной
+	SetGraduation(ctx context.Context, in *SetGraduationRequest, opts ...grpc.CallOption) (*SetGraduationResponse, error)
+	// GetFormedHabits получает выработанные привычки пользователя
+	GetFormedHabits(ctx context.Context, in *GetFormedHabitsRequest, opts ...grpc.CallOption) (*GetFormedHabitsResponse, error)
+	// SetChallenge ограничивает привычку датами начала и окончания
+	SetChallenge(ctx context.Context, in *SetChallengeRequest, opts ...grpc.CallOption) (*SetChallengeResponse, error)
+	// GetChallengeReport получает отчет челленджа: итоговый после окончания, иначе промежуточный
+	GetChallengeReport(ctx context.Context, in *GetChallengeReportRequest, opts ...grpc.CallOption) (*GetChallengeReportResponse, error)
 }
 
 type habitServiceClient struct {
@@ -144,6 +168,36 @@ func (c *habitServiceClient) SetMonthlyDays(ctx context.Context, in *SetMonthlyD
 	return out, nil
 }
 
+func (c *habitServiceClient) SetRecurrenceRule(ctx context.Context, in *SetRecurrenceRuleRequest, opts ...grpc.CallOption) (*SetRecurrenceRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRecurrenceRuleResponse)
+	err := c.cc.Invoke(ctx, HabitService_SetRecurrenceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) SetTarget(ctx context.Context, in *SetTargetRequest, opts ...grpc.CallOption) (*SetTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTargetResponse)
+	err := c.cc.Invoke(ctx, HabitService_SetTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) SetDailyCount(ctx context.Context, in *SetDailyCountRequest, opts ...grpc.CallOption) (*SetDailyCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDailyCountResponse)
+	err := c.cc.Invoke(ctx, HabitService_SetDailyCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *habitServiceClient) IsScheduledToday(ctx context.Context, in *IsScheduledTodayRequest, opts ...grpc.CallOption) (*IsScheduledTodayResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IsScheduledTodayResponse)
@@ -154,6 +208,56 @@ func (c *habitServiceClient) IsScheduledToday(ctx context.Context, in *IsSchedul
 	return out, nil
 }
 
+func (c *habitServiceClient) RecomputeStreaks(ctx context.Context, in *RecomputeStreaksRequest, opts ...grpc.CallOption) (*RecomputeStreaksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeStreaksResponse)
+	err := c.cc.Invoke(ctx, HabitService_RecomputeStreaks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) SetGraduation(ctx context.Context, in *SetGraduationRequest, opts ...grpc.CallOption) (*SetGraduationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGraduationResponse)
+	err := c.cc.Invoke(ctx, HabitService_SetGraduation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetFormedHabits(ctx context.Context, in *GetFormedHabitsRequest, opts ...grpc.CallOption) (*GetFormedHabitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFormedHabitsResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetFormedHabits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) SetChallenge(ctx context.Context, in *SetChallengeRequest, opts ...grpc.CallOption) (*SetChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChallengeResponse)
+	err := c.cc.Invoke(ctx, HabitService_SetChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *habitServiceClient) GetChallengeReport(ctx context.Context, in *GetChallengeReportRequest, opts ...grpc.CallOption) (*GetChallengeReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChallengeReportResponse)
+	err := c.cc.Invoke(ctx, HabitService_GetChallengeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HabitServiceServer is the server API for HabitService service.
 // All implementations must embed UnimplementedHabitServiceServer
 // for forward compatibility.
@@ -176,8 +280,24 @@ type HabitServiceServer interface {
 	SetWeeklyDays(context.Context, *SetWeeklyDaysRequest) (*SetWeeklyDaysResponse, error)
 	// SetMonthlyDays устанавливает дни месяца для ежемесячной привычки
 	SetMonthlyDays(context.Context, *SetMonthlyDaysRequest) (*SetMonthlyDaysResponse, error)
+	// SetRecurrenceRule задает правило повторения RFC 5545 (RRULE) вместо frequency
+	SetRecurrenceRule(context.Context, *SetRecurrenceRuleRequest) (*SetRecurrenceRuleResponse, error)
+	// SetTarget задает дневную цель количественной привычки
+	SetTarget(context.Context, *SetTargetRequest) (*SetTargetResponse, error)
+	// SetDailyCount задает, сколько раз за день нужно выполнить привычку
+	SetDailyCount(context.Context, *SetDailyCountRequest) (*SetDailyCountResponse, error)
 	// IsScheduledToday проверяет, нужно ли подтверждение сегодня
 	IsScheduledToday(context.Context, *IsScheduledTodayRequest) (*IsScheduledTodayResponse, error)
+	// RecomputeStreaks пересчитывает стрики по истории логов: одной привычки или всех привычек пользователя
+	RecomputeStreaks(context.Context, *RecomputeStreaksRequest) (*RecomputeStreaksResponse, error)
+	// SetGraduation задает критерии, по которым привычка считается выработанной
+	SetGraduation(context.Context, *SetGraduationRequest) (*SetGraduationResponse, error)
+	// GetFormedHabits получает выработанные привычки пользователя
+	GetFormedHabits(context.Context, *GetFormedHabitsRequest) (*GetFormedHabitsResponse, error)
+	// SetChallenge ограничивает привычку датами начала и окончания
+	SetChallenge(context.Context, *SetChallengeRequest) (*SetChallengeResponse, error)
+	// GetChallengeReport получает отчет челленджа: итоговый после окончания, иначе промежуточный
+	GetChallengeReport(context.Context, *GetChallengeReportRequest) (*GetChallengeReportResponse, error)
 	mustEmbedUnimplementedHabitServiceServer()
 }
 
@@ -212,9 +332,33 @@ func (UnimplementedHabitServiceServer) SetWeeklyDays(context.Context, *SetWeekly
 func (UnimplementedHabitServiceServer) SetMonthlyDays(context.Context, *SetMonthlyDaysRequest) (*SetMonthlyDaysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMonthlyDays not implemented")
 }
+func (UnimplementedHabitServiceServer) SetRecurrenceRule(context.Context, *SetRecurrenceRuleRequest) (*SetRecurrenceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecurrenceRule not implemented")
+}
+func (UnimplementedHabitServiceServer) SetTarget(context.Context, *SetTargetRequest) (*SetTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTarget not implemented")
+}
+func (UnimplementedHabitServiceServer) SetDailyCount(context.Context, *SetDailyCountRequest) (*SetDailyCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDailyCount not implemented")
+}
 func (UnimplementedHabitServiceServer) IsScheduledToday(context.Context, *IsScheduledTodayRequest) (*IsScheduledTodayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsScheduledToday not implemented")
 }
+func (UnimplementedHabitServiceServer) RecomputeStreaks(context.Context, *RecomputeStreaksRequest) (*RecomputeStreaksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeStreaks not implemented")
+}
+func (UnimplementedHabitServiceServer) SetGraduation(context.Context, *SetGraduationRequest) (*SetGraduationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGraduation not implemented")
+}
+func (UnimplementedHabitServiceServer) GetFormedHabits(context.Context, *GetFormedHabitsRequest) (*GetFormedHabitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFormedHabits not implemented")
+}
+func (UnimplementedHabitServiceServer) SetChallenge(context.Context, *SetChallengeRequest) (*SetChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChallenge not implemented")
+}
+func (UnimplementedHabitServiceServer) GetChallengeReport(context.Context, *GetChallengeReportRequest) (*GetChallengeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallengeReport not implemented")
+}
 func (UnimplementedHabitServiceServer) mustEmbedUnimplementedHabitServiceServer() {}
 func (UnimplementedHabitServiceServer) testEmbeddedByValue()                      {}
 
@@ -380,6 +524,60 @@ func _HabitService_SetMonthlyDays_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HabitService_SetRecurrenceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecurrenceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).SetRecurrenceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_SetRecurrenceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).SetRecurrenceRule(ctx, req.(*SetRecurrenceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_SetTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).SetTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_SetTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).SetTarget(ctx, req.(*SetTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_SetDailyCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDailyCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).SetDailyCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_SetDailyCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).SetDailyCount(ctx, req.(*SetDailyCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HabitService_IsScheduledToday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsScheduledTodayRequest)
 	if err := dec(in); err != nil {
@@ -398,6 +596,96 @@ func _HabitService_IsScheduledToday_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HabitService_RecomputeStreaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeStreaksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).RecomputeStreaks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_RecomputeStreaks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).RecomputeStreaks(ctx, req.(*RecomputeStreaksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_SetGraduation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGraduationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).SetGraduation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_SetGraduation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).SetGraduation(ctx, req.(*SetGraduationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetFormedHabits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFormedHabitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetFormedHabits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetFormedHabits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetFormedHabits(ctx, req.(*GetFormedHabitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_SetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).SetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_SetChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).SetChallenge(ctx, req.(*SetChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HabitService_GetChallengeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChallengeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HabitServiceServer).GetChallengeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HabitService_GetChallengeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HabitServiceServer).GetChallengeReport(ctx, req.(*GetChallengeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HabitService_ServiceDesc is the grpc.ServiceDesc for HabitService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -437,10 +725,42 @@ var HabitService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMonthlyDays",
 			Handler:    _HabitService_SetMonthlyDays_Handler,
 		},
+		{
+			MethodName: "SetRecurrenceRule",
+			Handler:    _HabitService_SetRecurrenceRule_Handler,
+		},
+		{
+			MethodName: "SetTarget",
+			Handler:    _HabitService_SetTarget_Handler,
+		},
+		{
+			MethodName: "SetDailyCount",
+			Handler:    _HabitService_SetDailyCount_Handler,
+		},
 		{
 			MethodName: "IsScheduledToday",
 			Handler:    _HabitService_IsScheduledToday_Handler,
 		},
+		{
+			MethodName: "RecomputeStreaks",
+			Handler:    _HabitService_RecomputeStreaks_Handler,
+		},
+		{
+			MethodName: "SetGraduation",
+			Handler:    _HabitService_SetGraduation_Handler,
+		},
+		{
+			MethodName: "GetFormedHabits",
+			Handler:    _HabitService_GetFormedHabits_Handler,
+		},
+		{
+			MethodName: "SetChallenge",
+			Handler:    _HabitService_SetChallenge_Handler,
+		},
+		{
+			MethodName: "GetChallengeReport",
+			Handler:    _HabitService_GetChallengeReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "habit_service.proto",
@@ -22,18 +22,105 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Skip уважительный пропуск запланированного дня
+type Skip struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HabitId       int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkipDate      string                 `protobuf:"bytes,4,opt,name=skip_date,json=skipDate,proto3" json:"skip_date,omitempty"` // ISO 8601 date
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Skip) Reset() {
+	*x = Skip{}
+	mi := &file_log_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Skip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Skip) ProtoMessage() {}
+
+func (x *Skip) ProtoReflect() protoreflect.Message {
+	mi := &file_log_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Skip.ProtoReflect.Descriptor instead.
+func (*Skip) Descriptor() ([]byte, []int) {
+	return file_log_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Skip) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Skip) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *Skip) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Skip) GetSkipDate() string {
+	if x != nil {
+		return x.SkipDate
+	}
+	return ""
+}
+
+func (x *Skip) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Skip) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type LogCompletionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
 	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"` // optional comment
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`                         // optional comment
+	LoggedDate    string                 `protobuf:"bytes,4,opt,name=logged_date,json=loggedDate,proto3" json:"logged_date,omitempty"` // optional ISO 8601 date, по умолчанию сегодня
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`                           // для количественной привычки: прибавляется к значению за день
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LogCompletionRequest) Reset() {
 	*x = LogCompletionRequest{}
-	mi := &file_log_service_proto_msgTypes[0]
+	mi := &file_log_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +132,7 @@ func (x *LogCompletionRequest) String() string {
 func (*LogCompletionRequest) ProtoMessage() {}
 
 func (x *LogCompletionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_log_service_proto_msgTypes[0]
+	mi := &file_log_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +145,7 @@ func (x *LogCompletionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogCompletionRequest.ProtoReflect.Descriptor instead.
 func (*LogCompletionRequest) Descriptor() ([]byte, []int) {
-	return file_log_service_proto_rawDescGZIP(), []int{0}
+	return file_log_service_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *LogCompletionRequest) GetHabitId() int32 {
@@ -82,17 +169,35 @@ func (x *LogCompletionRequest) GetComment() string {
 	return ""
 }
 
+func (x *LogCompletionRequest) GetLoggedDate() string {
+	if x != nil {
+		return x.LoggedDate
+	}
+	return ""
+}
+
+func (x *LogCompletionRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
 type LogCompletionResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Log               *HabitLog              `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 	IsFirstCompletion bool                   `protobuf:"varint,2,opt,name=is_first_completion,json=isFirstCompletion,proto3" json:"is_first_completion,omitempty"`
+	DayCompleted      bool                   `protobuf:"varint,3,opt,name=day_completed,json=dayCompleted,proto3" json:"day_completed,omitempty"`             // день засчитан: цель достигнута или привычка бинарная
+	DayTotal          float64                `protobuf:"fixed64,4,opt,name=day_total,json=dayTotal,proto3" json:"day_total,omitempty"`                        // сумма значений за день
+	CompletionsToday  int32                  `protobuf:"varint,5,opt,name=completions_today,json=completionsToday,proto3" json:"completions_today,omitempty"` // прогресс за день: completions_today из daily_count
+	DailyCount        int32                  `protobuf:"varint,6,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LogCompletionResponse) Reset() {
 	*x = LogCompletionResponse{}
-	mi := &file_log_service_proto_msgTypes[1]
+	mi := &file_log_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -104,7 +209,7 @@ func (x *LogCompletionResponse) String() string {
 func (*LogCompletionResponse) ProtoMessage() {}
 
 func (x *LogCompletionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_log_service_proto_msgTypes[1]
+	mi := &file_log_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +222,7 @@ func (x *LogCompletionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogCompletionResponse.ProtoReflect.Descriptor instead.
 func (*LogCompletionResponse) Descriptor() ([]byte, []int) {
-	return file_log_service_proto_rawDescGZIP(), []int{1}
+	return file_log_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *LogCompletionResponse) GetLog() *HabitLog {
@@ -134,6 +239,138 @@ func (x *LogCompletionResponse) GetIsFirstCompletion() bool {
 	return false
 }
 
+func (x *LogCompletionResponse) GetDayCompleted() bool {
+	if x != nil {
+		return x.DayCompleted
+	}
+	return false
+}
+
+func (x *LogCompletionResponse) GetDayTotal() float64 {
+	if x != nil {
+		return x.DayTotal
+	}
+	return 0
+}
+
+func (x *LogCompletionResponse) GetCompletionsToday() int32 {
+	if x != nil {
+		return x.CompletionsToday
+	}
+	return 0
+}
+
+func (x *LogCompletionResponse) GetDailyCount() int32 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+type DeleteLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LogId         int32                  `protobuf:"varint,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLogRequest) Reset() {
+	*x = DeleteLogRequest{}
+	mi := &file_log_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLogRequest) ProtoMessage() {}
+
+func (x *DeleteLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_log_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLogRequest) Descriptor() ([]byte, []int) {
+	return file_log_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteLogRequest) GetLogId() int32 {
+	if x != nil {
+		return x.LogId
+	}
+	return 0
+}
+
+func (x *DeleteLogRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Habit         *Habit                 `protobuf:"bytes,2,opt,name=habit,proto3" json:"habit,omitempty"` // привычка с пересчитанным стриком
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLogResponse) Reset() {
+	*x = DeleteLogResponse{}
+	mi := &file_log_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLogResponse) ProtoMessage() {}
+
+func (x *DeleteLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_log_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLogResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLogResponse) Descriptor() ([]byte, []int) {
+	return file_log_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteLogResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteLogResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
 type GetHabitLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
@@ -143,7 +380,7 @@ type GetHabitLogsRequest struct {
 
 func (x *GetHabitLogsRequest) Reset() {
 	*x = GetHabitLogsRequest{}
-	mi := &file_log_service_proto_msgTypes[2]
+	mi := &file_log_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -155,7 +392,7 @@ func (x *GetHabitLogsRequest) String() string {
 func (*GetHabitLogsRequest) ProtoMessage() {}
 
 func (x *GetHabitLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_log_service_proto_msgTypes[2]
+	mi := &file_log_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,7 +405,7 @@ func (x *GetHabitLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHabitLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetHabitLogsRequest) Descriptor() ([]byte, []int) {
-	return file_log_service_proto_rawDescGZIP(), []int{2}
+	return file_log_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetHabitLogsRequest) GetHabitId() int32 {
@@ -187,7 +424,7 @@ type GetHabitLogsResponse struct {
 
 func (x *GetHabitLogsResponse) Reset() {
 	*x = GetHabitLogsResponse{}
-	mi := &file_log_service_proto_msgTypes[3]
+	mi := &file_log_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +436,7 @@ func (x *GetHabitLogsResponse) String() string {
 func (*GetHabitLogsResponse) ProtoMessage() {}
 
 func (x *GetHabitLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_log_service_proto_msgTypes[3]
+	mi := &file_log_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +449,7 @@ func (x *GetHabitLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHabitLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetHabitLogsResponse) Descriptor() ([]byte, []int) {
-	return file_log_service_proto_rawDescGZIP(), []int{3}
+	return file_log_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetHabitLogsResponse) GetLogs() []*HabitLog {
@@ -233,7 +470,7 @@ type GetHabitLogsByDateRangeRequest struct {
 
 func (x *GetHabitLogsByDateRangeRequest) Reset() {
 	*x = GetHabitLogsByDateRangeRequest{}
-	mi := &file_log_service_proto_msgTypes[4]
+	mi := &file_log_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +482,7 @@ func (x *GetHabitLogsByDateRangeRequest) String() string {
 func (*GetHabitLogsByDateRangeRequest) ProtoMessage() {}
 
 func (x *GetHabitLogsByDateRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_log_service_proto_msgTypes[4]
+	mi := &file_log_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +495,7 @@ func (x *GetHabitLogsByDateRangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHabitLogsByDateRangeRequest.ProtoReflect.Descriptor instead.
 func (*GetHabitLogsByDateRangeRequest) Descriptor() ([]byte, []int) {
-	return file_log_service_proto_rawDescGZIP(), []int{4}
+	return file_log_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetHabitLogsByDateRangeRequest) GetHabitId() int32 {
@@ -291,7 +528,7 @@ type GetHabitLogsByDateRangeResponse struct {
 
 func (x *GetHabitLogsByDateRangeResponse) Reset() {
 	*x = GetHabitLogsByDateRangeResponse{}
-	mi := &file_log_service_proto_msgTypes[5]
+	mi := &file_log_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +540,7 @@ func (x *GetHabitLogsByDateRangeResponse) String() string {
 func (*GetHabitLogsByDateRangeResponse) ProtoMessage() {}
 
 func (x *GetHabitLogsByDateRangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_log_service_proto_msgTypes[5]
+	mi := &file_log_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +553,7 @@ func (x *GetHabitLogsByDateRangeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHabitLogsByDateRangeResponse.ProtoReflect.Descriptor instead.
 func (*GetHabitLogsByDateRangeResponse) Descriptor() ([]byte, []int) {
-	return file_log_service_proto_rawDescGZIP(), []int{5}
+	return file_log_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetHabitLogsByDateRangeResponse) GetLogs() []*HabitLog {
@@ -337,7 +574,7 @@ type GetCompletionRateRequest struct {
 
 func (x *GetCompletionRateRequest) Reset() {
 	*x = GetCompletionRateRequest{}
-	mi := &file_log_service_proto_msgTypes[6]
+	mi := &file_log_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +586,7 @@ func (x *GetCompletionRateRequest) String() string {
 func (*GetCompletionRateRequest) ProtoMessage() {}
 
 func (x *GetCompletionRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_log_service_proto_msgTypes[6]
+	mi := &file_log_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +599,7 @@ func (x *GetCompletionRateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompletionRateRequest.ProtoReflect.Descriptor instead.
 func (*GetCompletionRateRequest) Descriptor() ([]byte, []int) {
-	return file_log_service_proto_rawDescGZIP(), []int{6}
+	return file_log_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetCompletionRateRequest) GetHabitId() int32 {
@@ -391,13 +628,18 @@ type GetCompletionRateResponse struct {
 	Rate          float32                `protobuf:"fixed32,1,opt,name=rate,proto3" json:"rate,omitempty"` // percentage 0-100
 	Completed     int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
 	Scheduled     int32                  `protobuf:"varint,3,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	TotalValue    float64                `protobuf:"fixed64,4,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`       // сумма значений за период для количественной привычки
+	AverageValue  float64                `protobuf:"fixed64,5,opt,name=average_value,json=averageValue,proto3" json:"average_value,omitempty"` // среднее за день с логом
+	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	TargetValue   float64                `protobuf:"fixed64,7,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`
+	Skipped       int32                  `protobuf:"varint,8,opt,name=skipped,proto3" json:"skipped,omitempty"` // уважительно пропущенные дни, не входят в scheduled
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCompletionRateResponse) Reset() {
 	*x = GetCompletionRateResponse{}
-	mi := &file_log_service_proto_msgTypes[7]
+	mi := &file_log_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +651,7 @@ func (x *GetCompletionRateResponse) String() string {
 func (*GetCompletionRateResponse) ProtoMessage() {}
 
 func (x *GetCompletionRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_log_service_proto_msgTypes[7]
+	mi := &file_log_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +664,7 @@ func (x *GetCompletionRateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompletionRateResponse.ProtoReflect.Descriptor instead.
 func (*GetCompletionRateResponse) Descriptor() ([]byte, []int) {
-	return file_log_service_proto_rawDescGZIP(), []int{7}
+	return file_log_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetCompletionRateResponse) GetRate() float32 {
@@ -446,18 +688,195 @@ func (x *GetCompletionRateResponse) GetScheduled() int32 {
 	return 0
 }
 
+func (x *GetCompletionRateResponse) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *GetCompletionRateResponse) GetAverageValue() float64 {
+	if x != nil {
+		return x.AverageValue
+	}
+	return 0
+}
+
+func (x *GetCompletionRateResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *GetCompletionRateResponse) GetTargetValue() float64 {
+	if x != nil {
+		return x.TargetValue
+	}
+	return 0
+}
+
+func (x *GetCompletionRateResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type SkipDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`     // optional ISO 8601 date, по умолчанию сегодня
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // "день отдыха", "зал закрыт"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipDayRequest) Reset() {
+	*x = SkipDayRequest{}
+	mi := &file_log_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipDayRequest) ProtoMessage() {}
+
+func (x *SkipDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_log_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipDayRequest.ProtoReflect.Descriptor instead.
+func (*SkipDayRequest) Descriptor() ([]byte, []int) {
+	return file_log_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SkipDayRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *SkipDayRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SkipDayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SkipDayRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SkipDayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skip          *Skip                  `protobuf:"bytes,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Habit         *Habit                 `protobuf:"bytes,2,opt,name=habit,proto3" json:"habit,omitempty"` // привычка с пересчитанным стриком
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipDayResponse) Reset() {
+	*x = SkipDayResponse{}
+	mi := &file_log_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipDayResponse) ProtoMessage() {}
+
+func (x *SkipDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_log_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipDayResponse.ProtoReflect.Descriptor instead.
+func (*SkipDayResponse) Descriptor() ([]byte, []int) {
+	return file_log_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SkipDayResponse) GetSkip() *Skip {
+	if x != nil {
+		return x.Skip
+	}
+	return nil
+}
+
+func (x *SkipDayResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
 var File_log_service_proto protoreflect.FileDescriptor
 
 const file_log_service_proto_rawDesc = "" +
 	"\n" +
-	"\x11log_service.proto\x12\x0ehobbits.api.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"d\n" +
+	"\x11log_service.proto\x12\x0ehobbits.api.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xba\x01\n" +
+	"\x04Skip\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tskip_date\x18\x04 \x01(\tR\bskipDate\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9b\x01\n" +
 	"\x14LogCompletionRequest\x12\x19\n" +
 	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x18\n" +
-	"\acomment\x18\x03 \x01(\tR\acomment\"s\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1f\n" +
+	"\vlogged_date\x18\x04 \x01(\tR\n" +
+	"loggedDate\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\"\x83\x02\n" +
 	"\x15LogCompletionResponse\x12*\n" +
 	"\x03log\x18\x01 \x01(\v2\x18.hobbits.api.v1.HabitLogR\x03log\x12.\n" +
-	"\x13is_first_completion\x18\x02 \x01(\bR\x11isFirstCompletion\"0\n" +
+	"\x13is_first_completion\x18\x02 \x01(\bR\x11isFirstCompletion\x12#\n" +
+	"\rday_completed\x18\x03 \x01(\bR\fdayCompleted\x12\x1b\n" +
+	"\tday_total\x18\x04 \x01(\x01R\bdayTotal\x12+\n" +
+	"\x11completions_today\x18\x05 \x01(\x05R\x10completionsToday\x12\x1f\n" +
+	"\vdaily_count\x18\x06 \x01(\x05R\n" +
+	"dailyCount\"B\n" +
+	"\x10DeleteLogRequest\x12\x15\n" +
+	"\x06log_id\x18\x01 \x01(\x05R\x05logId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"Z\n" +
+	"\x11DeleteLogResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12+\n" +
+	"\x05habit\x18\x02 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"0\n" +
 	"\x13GetHabitLogsRequest\x12\x19\n" +
 	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\"D\n" +
 	"\x14GetHabitLogsResponse\x12,\n" +
@@ -471,17 +890,33 @@ const file_log_service_proto_rawDesc = "" +
 	"\x18GetCompletionRateRequest\x12\x19\n" +
 	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x127\n" +
 	"\tfrom_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
-	"\ato_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\"k\n" +
+	"\ato_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\"\x82\x02\n" +
 	"\x19GetCompletionRateResponse\x12\x12\n" +
 	"\x04rate\x18\x01 \x01(\x02R\x04rate\x12\x1c\n" +
 	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12\x1c\n" +
-	"\tscheduled\x18\x03 \x01(\x05R\tscheduled2\xab\x03\n" +
+	"\tscheduled\x18\x03 \x01(\x05R\tscheduled\x12\x1f\n" +
+	"\vtotal_value\x18\x04 \x01(\x01R\n" +
+	"totalValue\x12#\n" +
+	"\raverage_value\x18\x05 \x01(\x01R\faverageValue\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\x12!\n" +
+	"\ftarget_value\x18\a \x01(\x01R\vtargetValue\x12\x18\n" +
+	"\askipped\x18\b \x01(\x05R\askipped\"p\n" +
+	"\x0eSkipDayRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"h\n" +
+	"\x0fSkipDayResponse\x12(\n" +
+	"\x04skip\x18\x01 \x01(\v2\x14.hobbits.api.v1.SkipR\x04skip\x12+\n" +
+	"\x05habit\x18\x02 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit2\xc9\x04\n" +
 	"\n" +
 	"LogService\x12\\\n" +
-	"\rLogCompletion\x12$.hobbits.api.v1.LogCompletionRequest\x1a%.hobbits.api.v1.LogCompletionResponse\x12Y\n" +
+	"\rLogCompletion\x12$.hobbits.api.v1.LogCompletionRequest\x1a%.hobbits.api.v1.LogCompletionResponse\x12P\n" +
+	"\tDeleteLog\x12 .hobbits.api.v1.DeleteLogRequest\x1a!.hobbits.api.v1.DeleteLogResponse\x12Y\n" +
 	"\fGetHabitLogs\x12#.hobbits.api.v1.GetHabitLogsRequest\x1a$.hobbits.api.v1.GetHabitLogsResponse\x12z\n" +
 	"\x17GetHabitLogsByDateRange\x12..hobbits.api.v1.GetHabitLogsByDateRangeRequest\x1a/.hobbits.api.v1.GetHabitLogsByDateRangeResponse\x12h\n" +
-	"\x11GetCompletionRate\x12(.hobbits.api.v1.GetCompletionRateRequest\x1a).hobbits.api.v1.GetCompletionRateResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
+	"\x11GetCompletionRate\x12(.hobbits.api.v1.GetCompletionRateRequest\x1a).hobbits.api.v1.GetCompletionRateResponse\x12J\n" +
+	"\aSkipDay\x12\x1e.hobbits.api.v1.SkipDayRequest\x1a\x1f.hobbits.api.v1.SkipDayResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
 
 var (
 	file_log_service_proto_rawDescOnce sync.Once
@@ -495,40 +930,54 @@ func file_log_service_proto_rawDescGZIP() []byte {
 	return file_log_service_proto_rawDescData
 }
 
-var file_log_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_log_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_log_service_proto_goTypes = []any{
-	(*LogCompletionRequest)(nil),            // 0: hobbits.api.v1.LogCompletionRequest
-	(*LogCompletionResponse)(nil),           // 1: hobbits.api.v1.LogCompletionResponse
-	(*GetHabitLogsRequest)(nil),             // 2: hobbits.api.v1.GetHabitLogsRequest
-	(*GetHabitLogsResponse)(nil),            // 3: hobbits.api.v1.GetHabitLogsResponse
-	(*GetHabitLogsByDateRangeRequest)(nil),  // 4: hobbits.api.v1.GetHabitLogsByDateRangeRequest
-	(*GetHabitLogsByDateRangeResponse)(nil), // 5: hobbits.api.v1.GetHabitLogsByDateRangeResponse
-	(*GetCompletionRateRequest)(nil),        // 6: hobbits.api.v1.GetCompletionRateRequest
-	(*GetCompletionRateResponse)(nil),       // 7: hobbits.api.v1.GetCompletionRateResponse
-	(*HabitLog)(nil),                        // 8: hobbits.api.v1.HabitLog
-	(*timestamppb.Timestamp)(nil),           // 9: google.protobuf.Timestamp
+	(*Skip)(nil),                            // 0: hobbits.api.v1.Skip
+	(*LogCompletionRequest)(nil),            // 1: hobbits.api.v1.LogCompletionRequest
+	(*LogCompletionResponse)(nil),           // 2: hobbits.api.v1.LogCompletionResponse
+	(*DeleteLogRequest)(nil),                // 3: hobbits.api.v1.DeleteLogRequest
+	(*DeleteLogResponse)(nil),               // 4: hobbits.api.v1.DeleteLogResponse
+	(*GetHabitLogsRequest)(nil),             // 5: hobbits.api.v1.GetHabitLogsRequest
+	(*GetHabitLogsResponse)(nil),            // 6: hobbits.api.v1.GetHabitLogsResponse
+	(*GetHabitLogsByDateRangeRequest)(nil),  // 7: hobbits.api.v1.GetHabitLogsByDateRangeRequest
+	(*GetHabitLogsByDateRangeResponse)(nil), // 8: hobbits.api.v1.GetHabitLogsByDateRangeResponse
+	(*GetCompletionRateRequest)(nil),        // 9: hobbits.api.v1.GetCompletionRateRequest
+	(*GetCompletionRateResponse)(nil),       // 10: hobbits.api.v1.GetCompletionRateResponse
+	(*SkipDayRequest)(nil),                  // 11: hobbits.api.v1.SkipDayRequest
+	(*SkipDayResponse)(nil),                 // 12: hobbits.api.v1.SkipDayResponse
+	(*timestamppb.Timestamp)(nil),           // 13: google.protobuf.Timestamp
+	(*HabitLog)(nil),                        // 14: hobbits.api.v1.HabitLog
+	(*Habit)(nil),                           // 15: hobbits.api.v1.Habit
 }
 var file_log_service_proto_depIdxs = []int32{
-	8,  // 0: hobbits.api.v1.LogCompletionResponse.log:type_name -> hobbits.api.v1.HabitLog
-	8,  // 1: hobbits.api.v1.GetHabitLogsResponse.logs:type_name -> hobbits.api.v1.HabitLog
-	9,  // 2: hobbits.api.v1.GetHabitLogsByDateRangeRequest.from_date:type_name -> google.protobuf.Timestamp
-	9,  // 3: hobbits.api.v1.GetHabitLogsByDateRangeRequest.to_date:type_name -> google.protobuf.Timestamp
-	8,  // 4: hobbits.api.v1.GetHabitLogsByDateRangeResponse.logs:type_name -> hobbits.api.v1.HabitLog
-	9,  // 5: hobbits.api.v1.GetCompletionRateRequest.from_date:type_name -> google.protobuf.Timestamp
-	9,  // 6: hobbits.api.v1.GetCompletionRateRequest.to_date:type_name -> google.protobuf.Timestamp
-	0,  // 7: hobbits.api.v1.LogService.LogCompletion:input_type -> hobbits.api.v1.LogCompletionRequest
-	2,  // 8: hobbits.api.v1.LogService.GetHabitLogs:input_type -> hobbits.api.v1.GetHabitLogsRequest
-	4,  // 9: hobbits.api.v1.LogService.GetHabitLogsByDateRange:input_type -> hobbits.api.v1.GetHabitLogsByDateRangeRequest
-	6,  // 10: hobbits.api.v1.LogService.GetCompletionRate:input_type -> hobbits.api.v1.GetCompletionRateRequest
-	1,  // 11: hobbits.api.v1.LogService.LogCompletion:output_type -> hobbits.api.v1.LogCompletionResponse
-	3,  // 12: hobbits.api.v1.LogService.GetHabitLogs:output_type -> hobbits.api.v1.GetHabitLogsResponse
-	5,  // 13: hobbits.api.v1.LogService.GetHabitLogsByDateRange:output_type -> hobbits.api.v1.GetHabitLogsByDateRangeResponse
-	7,  // 14: hobbits.api.v1.LogService.GetCompletionRate:output_type -> hobbits.api.v1.GetCompletionRateResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	13, // 0: hobbits.api.v1.Skip.created_at:type_name -> google.protobuf.Timestamp
+	14, // 1: hobbits.api.v1.LogCompletionResponse.log:type_name -> hobbits.api.v1.HabitLog
+	15, // 2: hobbits.api.v1.DeleteLogResponse.habit:type_name -> hobbits.api.v1.Habit
+	14, // 3: hobbits.api.v1.GetHabitLogsResponse.logs:type_name -> hobbits.api.v1.HabitLog
+	13, // 4: hobbits.api.v1.GetHabitLogsByDateRangeRequest.from_date:type_name -> google.protobuf.Timestamp
+	13, // 5: hobbits.api.v1.GetHabitLogsByDateRangeRequest.to_date:type_name -> google.protobuf.Timestamp
+	14, // 6: hobbits.api.v1.GetHabitLogsByDateRangeResponse.logs:type_name -> hobbits.api.v1.HabitLog
+	13, // 7: hobbits.api.v1.GetCompletionRateRequest.from_date:type_name -> google.protobuf.Timestamp
+	13, // 8: hobbits.api.v1.GetCompletionRateRequest.to_date:type_name -> google.protobuf.Timestamp
+	0,  // 9: hobbits.api.v1.SkipDayResponse.skip:type_name -> hobbits.api.v1.Skip
+	15, // 10: hobbits.api.v1.SkipDayResponse.habit:type_name -> hobbits.api.v1.Habit
+	1,  // 11: hobbits.api.v1.LogService.LogCompletion:input_type -> hobbits.api.v1.LogCompletionRequest
+	3,  // 12: hobbits.api.v1.LogService.DeleteLog:input_type -> hobbits.api.v1.DeleteLogRequest
+	5,  // 13: hobbits.api.v1.LogService.GetHabitLogs:input_type -> hobbits.api.v1.GetHabitLogsRequest
+	7,  // 14: hobbits.api.v1.LogService.GetHabitLogsByDateRange:input_type -> hobbits.api.v1.GetHabitLogsByDateRangeRequest
+	9,  // 15: hobbits.api.v1.LogService.GetCompletionRate:input_type -> hobbits.api.v1.GetCompletionRateRequest
+	11, // 16: hobbits.api.v1.LogService.SkipDay:input_type -> hobbits.api.v1.SkipDayRequest
+	2,  // 17: hobbits.api.v1.LogService.LogCompletion:output_type -> hobbits.api.v1.LogCompletionResponse
+	4,  // 18: hobbits.api.v1.LogService.DeleteLog:output_type -> hobbits.api.v1.DeleteLogResponse
+	6,  // 19: hobbits.api.v1.LogService.GetHabitLogs:output_type -> hobbits.api.v1.GetHabitLogsResponse
+	8,  // 20: hobbits.api.v1.LogService.GetHabitLogsByDateRange:output_type -> hobbits.api.v1.GetHabitLogsByDateRangeResponse
+	10, // 21: hobbits.api.v1.LogService.GetCompletionRate:output_type -> hobbits.api.v1.GetCompletionRateResponse
+	12, // 22: hobbits.api.v1.LogService.SkipDay:output_type -> hobbits.api.v1.SkipDayResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_log_service_proto_init() }
@@ -543,7 +992,7 @@ func file_log_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_log_service_proto_rawDesc), len(file_log_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
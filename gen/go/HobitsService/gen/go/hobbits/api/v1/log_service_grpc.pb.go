@@ -20,9 +20,11 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	LogService_LogCompletion_FullMethodName           = "/hobbits.api.v1.LogService/LogCompletion"
+	LogService_DeleteLog_FullMethodName               = "/hobbits.api.v1.LogService/DeleteLog"
 	LogService_GetHabitLogs_FullMethodName            = "/hobbits.api.v1.LogService/GetHabitLogs"
 	LogService_GetHabitLogsByDateRange_FullMethodName = "/hobbits.api.v1.LogService/GetHabitLogsByDateRange"
 	LogService_GetCompletionRate_FullMethodName       = "/hobbits.api.v1.LogService/GetCompletionRate"
+	LogService_SkipDay_FullMethodName                 = "/hobbits.api.v1.LogService/SkipDay"
 )
 
 // LogServiceClient is the client API for LogService service.
@@ -33,12 +35,17 @@ const (
 type LogServiceClient interface {
 	// LogCompletion логирует выполнение привычки
 	LogCompletion(ctx context.Context, in *LogCompletionRequest, opts ...grpc.CallOption) (*LogCompletionResponse, error)
+	// DeleteLog удаляет лог выполнения и откатывает стрик
+	DeleteLog(ctx context.Context, in *DeleteLogRequest, opts ...grpc.CallOption) (*DeleteLogResponse, error)
 	// GetHabitLogs получает логи привычки
 	GetHabitLogs(ctx context.Context, in *GetHabitLogsRequest, opts ...grpc.CallOption) (*GetHabitLogsResponse, error)
 	// GetHabitLogsByDateRange получает логи за период
 	GetHabitLogsByDateRange(ctx context.Context, in *GetHabitLogsByDateRangeRequest, opts ...grpc.CallOption) (*GetHabitLogsByDateRangeResponse, error)
 	// GetCompletionRate получает процент выполнения за период
 	GetCompletionRate(ctx context.Context, in *GetCompletionRateRequest, opts ...grpc.CallOption) (*GetCompletionRateResponse, error)
+	// SkipDay отмечает запланированный день как пропущенный по уважительной причине:
+	// стрик не обрывается и не продлевается
+	SkipDay(ctx context.Context, in *SkipDayRequest, opts ...grpc.CallOption) (*SkipDayResponse, error)
 }
 
 type logServiceClient struct {
@@ -59,6 +66,16 @@ func (c *logServiceClient) LogCompletion(ctx context.Context, in *LogCompletionR
 	return out, nil
 }
 
+func (c *logServiceClient) DeleteLog(ctx context.Context, in *DeleteLogRequest, opts ...grpc.CallOption) (*DeleteLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLogResponse)
+	err := c.cc.Invoke(ctx, LogService_DeleteLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *logServiceClient) GetHabitLogs(ctx context.Context, in *GetHabitLogsRequest, opts ...grpc.CallOption) (*GetHabitLogsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetHabitLogsResponse)
@@ -89,6 +106,16 @@ func (c *logServiceClient) GetCompletionRate(ctx context.Context, in *GetComplet
 	return out, nil
 }
 
+func (c *logServiceClient) SkipDay(ctx context.Context, in *SkipDayRequest, opts ...grpc.CallOption) (*SkipDayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipDayResponse)
+	err := c.cc.Invoke(ctx, LogService_SkipDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogServiceServer is the server API for LogService service.
 // All implementations must embed UnimplementedLogServiceServer
 // for forward compatibility.
@@ -97,12 +124,17 @@ func (c *logServiceClient) GetCompletionRate(ctx context.Context, in *GetComplet
 type LogServiceServer interface {
 	// LogCompletion логирует выполнение привычки
 	LogCompletion(context.Context, *LogCompletionRequest) (*LogCompletionResponse, error)
+	// DeleteLog удаляет лог выполнения и откатывает стрик
+	DeleteLog(context.Context, *DeleteLogRequest) (*DeleteLogResponse, error)
 	// GetHabitLogs получает логи привычки
 	GetHabitLogs(context.Context, *GetHabitLogsRequest) (*GetHabitLogsResponse, error)
 	// GetHabitLogsByDateRange получает логи за период
 	GetHabitLogsByDateRange(context.Context, *GetHabitLogsByDateRangeRequest) (*GetHabitLogsByDateRangeResponse, error)
 	// GetCompletionRate получает процент выполнения за период
 	GetCompletionRate(context.Context, *GetCompletionRateRequest) (*GetCompletionRateResponse, error)
+	// SkipDay отмечает запланированный день как пропущенный по уважительной причине:
+	// стрик не обрывается и не продлевается
+	SkipDay(context.Context, *SkipDayRequest) (*SkipDayResponse, error)
 	mustEmbedUnimplementedLogServiceServer()
 }
 
@@ -116,6 +148,9 @@ type UnimplementedLogServiceServer struct{}
 func (UnimplementedLogServiceServer) LogCompletion(context.Context, *LogCompletionRequest) (*LogCompletionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LogCompletion not implemented")
 }
+func (UnimplementedLogServiceServer) DeleteLog(context.Context, *DeleteLogRequest) (*DeleteLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLog not implemented")
+}
 func (UnimplementedLogServiceServer) GetHabitLogs(context.Context, *GetHabitLogsRequest) (*GetHabitLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHabitLogs not implemented")
 }
@@ -125,6 +160,9 @@ func (UnimplementedLogServiceServer) GetHabitLogsByDateRange(context.Context, *G
 func (UnimplementedLogServiceServer) GetCompletionRate(context.Context, *GetCompletionRateRequest) (*GetCompletionRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompletionRate not implemented")
 }
+func (UnimplementedLogServiceServer) SkipDay(context.Context, *SkipDayRequest) (*SkipDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipDay not implemented")
+}
 func (UnimplementedLogServiceServer) mustEmbedUnimplementedLogServiceServer() {}
 func (UnimplementedLogServiceServer) testEmbeddedByValue()                    {}
 
@@ -164,6 +202,24 @@ func _LogService_LogCompletion_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_DeleteLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).DeleteLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_DeleteLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).DeleteLog(ctx, req.(*DeleteLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LogService_GetHabitLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetHabitLogsRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +274,24 @@ func _LogService_GetCompletionRate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_SkipDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).SkipDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_SkipDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).SkipDay(ctx, req.(*SkipDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LogService_ServiceDesc is the grpc.ServiceDesc for LogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +303,10 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LogCompletion",
 			Handler:    _LogService_LogCompletion_Handler,
 		},
+		{
+			MethodName: "DeleteLog",
+			Handler:    _LogService_DeleteLog_Handler,
+		},
 		{
 			MethodName: "GetHabitLogs",
 			Handler:    _LogService_GetHabitLogs_Handler,
@@ -241,6 +319,10 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompletionRate",
 			Handler:    _LogService_GetCompletionRate_Handler,
 		},
+		{
+			MethodName: "SkipDay",
+			Handler:    _LogService_SkipDay_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "log_service.proto",
@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: pause_service.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Pause представляет паузу привычки или отпуск пользователя
+type Pause struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId       int32                  `protobuf:"varint,3,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"` // 0 - отпуск, действует на все привычки
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pause) Reset() {
+	*x = Pause{}
+	mi := &file_pause_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pause) ProtoMessage() {}
+
+func (x *Pause) ProtoReflect() protoreflect.Message {
+	mi := &file_pause_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pause.ProtoReflect.Descriptor instead.
+func (*Pause) Descriptor() ([]byte, []int) {
+	return file_pause_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pause) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pause) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Pause) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *Pause) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Pause) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Pause) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Pause) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Pause) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+type CreatePauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId       int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`      // 0 - отпуск
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO 8601 date, допускается прошлое в пределах окна отметки задним числом
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // ISO 8601 date, включительно
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePauseRequest) Reset() {
+	*x = CreatePauseRequest{}
+	mi := &file_pause_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePauseRequest) ProtoMessage() {}
+
+func (x *CreatePauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pause_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePauseRequest.ProtoReflect.Descriptor instead.
+func (*CreatePauseRequest) Descriptor() ([]byte, []int) {
+	return file_pause_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePauseRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePauseRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *CreatePauseRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreatePauseRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreatePauseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreatePauseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pause         *Pause                 `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePauseResponse) Reset() {
+	*x = CreatePauseResponse{}
+	mi := &file_pause_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePauseResponse) ProtoMessage() {}
+
+func (x *CreatePauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pause_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePauseResponse.ProtoReflect.Descriptor instead.
+func (*CreatePauseResponse) Descriptor() ([]byte, []int) {
+	return file_pause_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePauseResponse) GetPause() *Pause {
+	if x != nil {
+		return x.Pause
+	}
+	return nil
+}
+
+type ListPausesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId       int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"` // 0 - все паузы пользователя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPausesRequest) Reset() {
+	*x = ListPausesRequest{}
+	mi := &file_pause_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPausesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPausesRequest) ProtoMessage() {}
+
+func (x *ListPausesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pause_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPausesRequest.ProtoReflect.Descriptor instead.
+func (*ListPausesRequest) Descriptor() ([]byte, []int) {
+	return file_pause_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPausesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListPausesRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+type ListPausesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pauses        []*Pause               `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPausesResponse) Reset() {
+	*x = ListPausesResponse{}
+	mi := &file_pause_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPausesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPausesResponse) ProtoMessage() {}
+
+func (x *ListPausesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pause_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPausesResponse.ProtoReflect.Descriptor instead.
+func (*ListPausesResponse) Descriptor() ([]byte, []int) {
+	return file_pause_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPausesResponse) GetPauses() []*Pause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+type CancelPauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PauseId       int32                  `protobuf:"varint,1,opt,name=pause_id,json=pauseId,proto3" json:"pause_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPauseRequest) Reset() {
+	*x = CancelPauseRequest{}
+	mi := &file_pause_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPauseRequest) ProtoMessage() {}
+
+func (x *CancelPauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pause_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPauseRequest.ProtoReflect.Descriptor instead.
+func (*CancelPauseRequest) Descriptor() ([]byte, []int) {
+	return file_pause_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelPauseRequest) GetPauseId() int32 {
+	if x != nil {
+		return x.PauseId
+	}
+	return 0
+}
+
+func (x *CancelPauseRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CancelPauseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pause         *Pause                 `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPauseResponse) Reset() {
+	*x = CancelPauseResponse{}
+	mi := &file_pause_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPauseResponse) ProtoMessage() {}
+
+func (x *CancelPauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pause_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPauseResponse.ProtoReflect.Descriptor instead.
+func (*CancelPauseResponse) Descriptor() ([]byte, []int) {
+	return file_pause_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelPauseResponse) GetPause() *Pause {
+	if x != nil {
+		return x.Pause
+	}
+	return nil
+}
+
+var File_pause_service_proto protoreflect.FileDescriptor
+
+const file_pause_service_proto_rawDesc = "" +
+	"\n" +
+	"\x13pause_service.proto\x12\x0ehobbits.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\x02\n" +
+	"\x05Pause\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bhabit_id\x18\x03 \x01(\x05R\ahabitId\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcancelled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\"\x9a\x01\n" +
+	"\x12CreatePauseRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"B\n" +
+	"\x13CreatePauseResponse\x12+\n" +
+	"\x05pause\x18\x01 \x01(\v2\x15.hobbits.api.v1.PauseR\x05pause\"G\n" +
+	"\x11ListPausesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\"C\n" +
+	"\x12ListPausesResponse\x12-\n" +
+	"\x06pauses\x18\x01 \x03(\v2\x15.hobbits.api.v1.PauseR\x06pauses\"H\n" +
+	"\x12CancelPauseRequest\x12\x19\n" +
+	"\bpause_id\x18\x01 \x01(\x05R\apauseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"B\n" +
+	"\x13CancelPauseResponse\x12+\n" +
+	"\x05pause\x18\x01 \x01(\v2\x15.hobbits.api.v1.PauseR\x05pause2\x93\x02\n" +
+	"\fPauseService\x12V\n" +
+	"\vCreatePause\x12\".hobbits.api.v1.CreatePauseRequest\x1a#.hobbits.api.v1.CreatePauseResponse\x12S\n" +
+	"\n" +
+	"ListPauses\x12!.hobbits.api.v1.ListPausesRequest\x1a\".hobbits.api.v1.ListPausesResponse\x12V\n" +
+	"\vCancelPause\x12\".hobbits.api.v1.CancelPauseRequest\x1a#.hobbits.api.v1.CancelPauseResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
+
+var (
+	file_pause_service_proto_rawDescOnce sync.Once
+	file_pause_service_proto_rawDescData []byte
+)
+
+func file_pause_service_proto_rawDescGZIP() []byte {
+	file_pause_service_proto_rawDescOnce.Do(func() {
+		file_pause_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pause_service_proto_rawDesc), len(file_pause_service_proto_rawDesc)))
+	})
+	return file_pause_service_proto_rawDescData
+}
+
+var file_pause_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pause_service_proto_goTypes = []any{
+	(*Pause)(nil),                 // 0: hobbits.api.v1.Pause
+	(*CreatePauseRequest)(nil),    // 1: hobbits.api.v1.CreatePauseRequest
+	(*CreatePauseResponse)(nil),   // 2: hobbits.api.v1.CreatePauseResponse
+	(*ListPausesRequest)(nil),     // 3: hobbits.api.v1.ListPausesRequest
+	(*ListPausesResponse)(nil),    // 4: hobbits.api.v1.ListPausesResponse
+	(*CancelPauseRequest)(nil),    // 5: hobbits.api.v1.CancelPauseRequest
+	(*CancelPauseResponse)(nil),   // 6: hobbits.api.v1.CancelPauseResponse
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_pause_service_proto_depIdxs = []int32{
+	7,  // 0: hobbits.api.v1.Pause.start_date:type_name -> google.protobuf.Timestamp
+	7,  // 1: hobbits.api.v1.Pause.end_date:type_name -> google.protobuf.Timestamp
+	7,  // 2: hobbits.api.v1.Pause.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: hobbits.api.v1.Pause.cancelled_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: hobbits.api.v1.CreatePauseResponse.pause:type_name -> hobbits.api.v1.Pause
+	0,  // 5: hobbits.api.v1.ListPausesResponse.pauses:type_name -> hobbits.api.v1.Pause
+	0,  // 6: hobbits.api.v1.CancelPauseResponse.pause:type_name -> hobbits.api.v1.Pause
+	1,  // 7: hobbits.api.v1.PauseService.CreatePause:input_type -> hobbits.api.v1.CreatePauseRequest
+	3,  // 8: hobbits.api.v1.PauseService.ListPauses:input_type -> hobbits.api.v1.ListPausesRequest
+	5,  // 9: hobbits.api.v1.PauseService.CancelPause:input_type -> hobbits.api.v1.CancelPauseRequest
+	2,  // 10: hobbits.api.v1.PauseService.CreatePause:output_type -> hobbits.api.v1.CreatePauseResponse
+	4,  // 11: hobbits.api.v1.PauseService.ListPauses:output_type -> hobbits.api.v1.ListPausesResponse
+	6,  // 12: hobbits.api.v1.PauseService.CancelPause:output_type -> hobbits.api.v1.CancelPauseResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_pause_service_proto_init() }
+func file_pause_service_proto_init() {
+	if File_pause_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pause_service_proto_rawDesc), len(file_pause_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pause_service_proto_goTypes,
+		DependencyIndexes: file_pause_service_proto_depIdxs,
+		MessageInfos:      file_pause_service_proto_msgTypes,
+	}.Build()
+	File_pause_service_proto = out.File
+	file_pause_service_proto_goTypes = nil
+	file_pause_service_proto_depIdxs = nil
+}
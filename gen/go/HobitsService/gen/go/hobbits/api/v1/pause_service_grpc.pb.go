@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: pause_service.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PauseService_CreatePause_FullMethodName = "/hobbits.api.v1.PauseService/CreatePause"
+	PauseService_ListPauses_FullMethodName  = "/hobbits.api.v1.PauseService/ListPauses"
+	PauseService_CancelPause_FullMethodName = "/hobbits.api.v1.PauseService/CancelPause"
+)
+
+// PauseServiceClient is the client API for PauseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PauseService для пауз привычек и отпусков: дни паузы не запланированы,
+// не напоминаются, не обрывают стрик и не учитываются в проценте выполнения
+type PauseServiceClient interface {
+	// CreatePause ставит на паузу привычку или, без habit_id, все привычки пользователя (отпуск)
+	CreatePause(ctx context.Context, in *CreatePauseRequest, opts ...grpc.CallOption) (*CreatePauseResponse, error)
+	// ListPauses получает паузы пользователя
+	ListPauses(ctx context.Context, in *ListPausesRequest, opts ...grpc.CallOption) (*ListPausesResponse, error)
+	// CancelPause отменяет паузу; уже прошедшие дни остаются на паузе
+	CancelPause(ctx context.Context, in *CancelPauseRequest, opts ...grpc.CallOption) (*CancelPauseResponse, error)
+}
+
+type pauseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPauseServiceClient(cc grpc.ClientConnInterface) PauseServiceClient {
+	return &pauseServiceClient{cc}
+}
+
+func (c *pauseServiceClient) CreatePause(ctx context.Context, in *CreatePauseRequest, opts ...grpc.CallOption) (*CreatePauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePauseResponse)
+	err := c.cc.Invoke(ctx, PauseService_CreatePause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pauseServiceClient) ListPauses(ctx context.Context, in *ListPausesRequest, opts ...grpc.CallOption) (*ListPausesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPausesResponse)
+	err := c.cc.Invoke(ctx, PauseService_ListPauses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pauseServiceClient) CancelPause(ctx context.Context, in *CancelPauseRequest, opts ...grpc.CallOption) (*CancelPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPauseResponse)
+	err := c.cc.Invoke(ctx, PauseService_CancelPause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PauseServiceServer is the server API for PauseService service.
+// All implementations must embed UnimplementedPauseServiceServer
+// for forward compatibility.
+//
+// PauseService для пауз привычек и отпусков: дни паузы не запланированы,
+// не напоминаются, не обрывают стрик и не учитываются в проценте выполнения
+type PauseServiceServer interface {
+	// CreatePause ставит на паузу привычку или, без habit_id, все привычки пользователя (отпуск)
+	CreatePause(context.Context, *CreatePauseRequest) (*CreatePauseResponse, error)
+	// ListPauses получает паузы пользователя
+	ListPauses(context.Context, *ListPausesRequest) (*ListPausesResponse, error)
+	// CancelPause отменяет паузу; уже прошедшие дни остаются на паузе
+	CancelPause(context.Context, *CancelPauseRequest) (*CancelPauseResponse, error)
+	mustEmbedUnimplementedPauseServiceServer()
+}
+
+// UnimplementedPauseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPauseServiceServer struct{}
+
+func (UnimplementedPauseServiceServer) CreatePause(context.Context, *CreatePauseRequest) (*CreatePauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePause not implemented")
+}
+func (UnimplementedPauseServiceServer) ListPauses(context.Context, *ListPausesRequest) (*ListPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPauses not implemented")
+}
+func (UnimplementedPauseServiceServer) CancelPause(context.Context, *CancelPauseRequest) (*CancelPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPause not implemented")
+}
+func (UnimplementedPauseServiceServer) mustEmbedUnimplementedPauseServiceServer() {}
+func (UnimplementedPauseServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafePauseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PauseServiceServer will
+// result in compilation errors.
+type UnsafePauseServiceServer interface {
+	mustEmbedUnimplementedPauseServiceServer()
+}
+
+func RegisterPauseServiceServer(s grpc.ServiceRegistrar, srv PauseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPauseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PauseService_ServiceDesc, srv)
+}
+
+func _PauseService_CreatePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PauseServiceServer).CreatePause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PauseService_CreatePause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PauseServiceServer).CreatePause(ctx, req.(*CreatePauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PauseService_ListPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PauseServiceServer).ListPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PauseService_ListPauses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PauseServiceServer).ListPauses(ctx, req.(*ListPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PauseService_CancelPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PauseServiceServer).CancelPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PauseService_CancelPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PauseServiceServer).CancelPause(ctx, req.(*CancelPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PauseService_ServiceDesc is the grpc.ServiceDesc for PauseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PauseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hobbits.api.v1.PauseService",
+	HandlerType: (*PauseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePause",
+			Handler:    _PauseService_CreatePause_Handler,
+		},
+		{
+			MethodName: "ListPauses",
+			Handler:    _PauseService_ListPauses_Handler,
+		},
+		{
+			MethodName: "CancelPause",
+			Handler:    _PauseService_CancelPause_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pause_service.proto",
+}
@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: relapse_service.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Relapse срыв по привычке-отказу
+type Relapse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HabitId       int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RelapseDate   string                 `protobuf:"bytes,4,opt,name=relapse_date,json=relapseDate,proto3" json:"relapse_date,omitempty"` // ISO 8601 date
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relapse) Reset() {
+	*x = Relapse{}
+	mi := &file_relapse_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relapse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relapse) ProtoMessage() {}
+
+func (x *Relapse) ProtoReflect() protoreflect.Message {
+	mi := &file_relapse_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relapse.ProtoReflect.Descriptor instead.
+func (*Relapse) Descriptor() ([]byte, []int) {
+	return file_relapse_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Relapse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Relapse) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *Relapse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Relapse) GetRelapseDate() string {
+	if x != nil {
+		return x.RelapseDate
+	}
+	return ""
+}
+
+func (x *Relapse) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Relapse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// RelapsePeriod число срывов за неделю или месяц
+type RelapsePeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO 8601 date начала недели или месяца
+	Relapses      int32                  `protobuf:"varint,2,opt,name=relapses,proto3" json:"relapses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelapsePeriod) Reset() {
+	*x = RelapsePeriod{}
+	mi := &file_relapse_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelapsePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelapsePeriod) ProtoMessage() {}
+
+func (x *RelapsePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_relapse_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelapsePeriod.ProtoReflect.Descriptor instead.
+func (*RelapsePeriod) Descriptor() ([]byte, []int) {
+	return file_relapse_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RelapsePeriod) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *RelapsePeriod) GetRelapses() int32 {
+	if x != nil {
+		return x.Relapses
+	}
+	return 0
+}
+
+type LogRelapseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`                                  // optional
+	RelapseDate   string                 `protobuf:"bytes,4,opt,name=relapse_date,json=relapseDate,proto3" json:"relapse_date,omitempty"` // optional ISO 8601 date, по умолчанию сегодня
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogRelapseRequest) Reset() {
+	*x = LogRelapseRequest{}
+	mi := &file_relapse_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogRelapseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRelapseRequest) ProtoMessage() {}
+
+func (x *LogRelapseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relapse_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRelapseRequest.ProtoReflect.Descriptor instead.
+func (*LogRelapseRequest) Descriptor() ([]byte, []int) {
+	return file_relapse_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogRelapseRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *LogRelapseRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LogRelapseRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *LogRelapseRequest) GetRelapseDate() string {
+	if x != nil {
+		return x.RelapseDate
+	}
+	return ""
+}
+
+type LogRelapseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relapse       *Relapse               `protobuf:"bytes,1,opt,name=relapse,proto3" json:"relapse,omitempty"`
+	Habit         *Habit                 `protobuf:"bytes,2,opt,name=habit,proto3" json:"habit,omitempty"` // привычка с пересчитанным стриком
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogRelapseResponse) Reset() {
+	*x = LogRelapseResponse{}
+	mi := &file_relapse_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogRelapseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRelapseResponse) ProtoMessage() {}
+
+func (x *LogRelapseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relapse_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRelapseResponse.ProtoReflect.Descriptor instead.
+func (*LogRelapseResponse) Descriptor() ([]byte, []int) {
+	return file_relapse_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LogRelapseResponse) GetRelapse() *Relapse {
+	if x != nil {
+		return x.Relapse
+	}
+	return nil
+}
+
+func (x *LogRelapseResponse) GetHabit() *Habit {
+	if x != nil {
+		return x.Habit
+	}
+	return nil
+}
+
+type ListRelapsesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelapsesRequest) Reset() {
+	*x = ListRelapsesRequest{}
+	mi := &file_relapse_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelapsesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelapsesRequest) ProtoMessage() {}
+
+func (x *ListRelapsesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relapse_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelapsesRequest.ProtoReflect.Descriptor instead.
+func (*ListRelapsesRequest) Descriptor() ([]byte, []int) {
+	return file_relapse_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRelapsesRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+type ListRelapsesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relapses      []*Relapse             `protobuf:"bytes,1,rep,name=relapses,proto3" json:"relapses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelapsesResponse) Reset() {
+	*x = ListRelapsesResponse{}
+	mi := &file_relapse_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelapsesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelapsesResponse) ProtoMessage() {}
+
+func (x *ListRelapsesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relapse_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelapsesResponse.ProtoReflect.Descriptor instead.
+func (*ListRelapsesResponse) Descriptor() ([]byte, []int) {
+	return file_relapse_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRelapsesResponse) GetRelapses() []*Relapse {
+	if x != nil {
+		return x.Relapses
+	}
+	return nil
+}
+
+type GetRelapseStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // ISO 8601 date
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // ISO 8601 date, включительно
+	Period        string                 `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`                     // "week" (по умолчанию), "month"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelapseStatsRequest) Reset() {
+	*x = GetRelapseStatsRequest{}
+	mi := &file_relapse_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelapseStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelapseStatsRequest) ProtoMessage() {}
+
+func (x *GetRelapseStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relapse_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelapseStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelapseStatsRequest) Descriptor() ([]byte, []int) {
+	return file_relapse_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRelapseStatsRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *GetRelapseStatsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetRelapseStatsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *GetRelapseStatsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type GetRelapseStatsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentCleanRun int32                  `protobuf:"varint,1,opt,name=current_clean_run,json=currentCleanRun,proto3" json:"current_clean_run,omitempty"` // дни с последнего срыва
+	LongestCleanRun int32                  `protobuf:"varint,2,opt,name=longest_clean_run,json=longestCleanRun,proto3" json:"longest_clean_run,omitempty"`
+	TotalRelapses   int32                  `protobuf:"varint,3,opt,name=total_relapses,json=totalRelapses,proto3" json:"total_relapses,omitempty"` // за период
+	Periods         []*RelapsePeriod       `protobuf:"bytes,4,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRelapseStatsResponse) Reset() {
+	*x = GetRelapseStatsResponse{}
+	mi := &file_relapse_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelapseStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelapseStatsResponse) ProtoMessage() {}
+
+func (x *GetRelapseStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relapse_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelapseStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelapseStatsResponse) Descriptor() ([]byte, []int) {
+	return file_relapse_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRelapseStatsResponse) GetCurrentCleanRun() int32 {
+	if x != nil {
+		return x.CurrentCleanRun
+	}
+	return 0
+}
+
+func (x *GetRelapseStatsResponse) GetLongestCleanRun() int32 {
+	if x != nil {
+		return x.LongestCleanRun
+	}
+	return 0
+}
+
+func (x *GetRelapseStatsResponse) GetTotalRelapses() int32 {
+	if x != nil {
+		return x.TotalRelapses
+	}
+	return 0
+}
+
+func (x *GetRelapseStatsResponse) GetPeriods() []*RelapsePeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+var File_relapse_service_proto protoreflect.FileDescriptor
+
+const file_relapse_service_proto_rawDesc = "" +
+	"\n" +
+	"\x15relapse_service.proto\x12\x0ehobbits.api.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbf\x01\n" +
+	"\aRelapse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12!\n" +
+	"\frelapse_date\x18\x04 \x01(\tR\vrelapseDate\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	"\rRelapsePeriod\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x1a\n" +
+	"\brelapses\x18\x02 \x01(\x05R\brelapses\"~\n" +
+	"\x11LogRelapseRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12!\n" +
+	"\frelapse_date\x18\x04 \x01(\tR\vrelapseDate\"t\n" +
+	"\x12LogRelapseResponse\x121\n" +
+	"\arelapse\x18\x01 \x01(\v2\x17.hobbits.api.v1.RelapseR\arelapse\x12+\n" +
+	"\x05habit\x18\x02 \x01(\v2\x15.hobbits.api.v1.HabitR\x05habit\"0\n" +
+	"\x13ListRelapsesRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\"K\n" +
+	"\x14ListRelapsesResponse\x123\n" +
+	"\brelapses\x18\x01 \x03(\v2\x17.hobbits.api.v1.RelapseR\brelapses\"\x81\x01\n" +
+	"\x16GetRelapseStatsRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\"\xd1\x01\n" +
+	"\x17GetRelapseStatsResponse\x12*\n" +
+	"\x11current_clean_run\x18\x01 \x01(\x05R\x0fcurrentCleanRun\x12*\n" +
+	"\x11longest_clean_run\x18\x02 \x01(\x05R\x0flongestCleanRun\x12%\n" +
+	"\x0etotal_relapses\x18\x03 \x01(\x05R\rtotalRelapses\x127\n" +
+	"\aperiods\x18\x04 \x03(\v2\x1d.hobbits.api.v1.RelapsePeriodR\aperiods2\xa4\x02\n" +
+	"\x0eRelapseService\x12S\n" +
+	"\n" +
+	"LogRelapse\x12!.hobbits.api.v1.LogRelapseRequest\x1a\".hobbits.api.v1.LogRelapseResponse\x12Y\n" +
+	"\fListRelapses\x12#.hobbits.api.v1.ListRelapsesRequest\x1a$.hobbits.api.v1.ListRelapsesResponse\x12b\n" +
+	"\x0fGetRelapseStats\x12&.hobbits.api.v1.GetRelapseStatsRequest\x1a'.hobbits.api.v1.GetRelapseStatsResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
+
+var (
+	file_relapse_service_proto_rawDescOnce sync.Once
+	file_relapse_service_proto_rawDescData []byte
+)
+
+func file_relapse_service_proto_rawDescGZIP() []byte {
+	file_relapse_service_proto_rawDescOnce.Do(func() {
+		file_relapse_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_relapse_service_proto_rawDesc), len(file_relapse_service_proto_rawDesc)))
+	})
+	return file_relapse_service_proto_rawDescData
+}
+
+var file_relapse_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_relapse_service_proto_goTypes = []any{
+	(*Relapse)(nil),                 // 0: hobbits.api.v1.Relapse
+	(*RelapsePeriod)(nil),           // 1: hobbits.api.v1.RelapsePeriod
+	(*LogRelapseRequest)(nil),       // 2: hobbits.api.v1.LogRelapseRequest
+	(*LogRelapseResponse)(nil),      // 3: hobbits.api.v1.LogRelapseResponse
+	(*ListRelapsesRequest)(nil),     // 4: hobbits.api.v1.ListRelapsesRequest
+	(*ListRelapsesResponse)(nil),    // 5: hobbits.api.v1.ListRelapsesResponse
+	(*GetRelapseStatsRequest)(nil),  // 6: hobbits.api.v1.GetRelapseStatsRequest
+	(*GetRelapseStatsResponse)(nil), // 7: hobbits.api.v1.GetRelapseStatsResponse
+	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
+	(*Habit)(nil),                   // 9: hobbits.api.v1.Habit
+}
+var file_relapse_service_proto_depIdxs = []int32{
+	8, // 0: hobbits.api.v1.Relapse.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: hobbits.api.v1.LogRelapseResponse.relapse:type_name -> hobbits.api.v1.Relapse
+	9, // 2: hobbits.api.v1.LogRelapseResponse.habit:type_name -> hobbits.api.v1.Habit
+	0, // 3: hobbits.api.v1.ListRelapsesResponse.relapses:type_name -> hobbits.api.v1.Relapse
+	1, // 4: hobbits.api.v1.GetRelapseStatsResponse.periods:type_name -> hobbits.api.v1.RelapsePeriod
+	2, // 5: hobbits.api.v1.RelapseService.LogRelapse:input_type -> hobbits.api.v1.LogRelapseRequest
+	4, // 6: hobbits.api.v1.RelapseService.ListRelapses:input_type -> hobbits.api.v1.ListRelapsesRequest
+	6, // 7: hobbits.api.v1.RelapseService.GetRelapseStats:input_type -> hobbits.api.v1.GetRelapseStatsRequest
+	3, // 8: hobbits.api.v1.RelapseService.LogRelapse:output_type -> hobbits.api.v1.LogRelapseResponse
+	5, // 9: hobbits.api.v1.RelapseService.ListRelapses:output_type -> hobbits.api.v1.ListRelapsesResponse
+	7, // 10: hobbits.api.v1.RelapseService.GetRelapseStats:output_type -> hobbits.api.v1.GetRelapseStatsResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_relapse_service_proto_init() }
+func file_relapse_service_proto_init() {
+	if File_relapse_service_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_relapse_service_proto_rawDesc), len(file_relapse_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_relapse_service_proto_goTypes,
+		DependencyIndexes: file_relapse_service_proto_depIdxs,
+		MessageInfos:      file_relapse_service_proto_msgTypes,
+	}.Build()
+	File_relapse_service_proto = out.File
+	file_relapse_service_proto_goTypes = nil
+	file_relapse_service_proto_depIdxs = nil
+}
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: relapse_service.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RelapseService_LogRelapse_FullMethodName      = "/hobbits.api.v1.RelapseService/LogRelapse"
+	RelapseService_ListRelapses_FullMethodName    = "/hobbits.api.v1.RelapseService/ListRelapses"
+	RelapseService_GetRelapseStats_FullMethodName = "/hobbits.api.v1.RelapseService/GetRelapseStats"
+)
+
+// RelapseServiceClient is the client API for RelapseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RelapseService для привычек-отказов (frequency "quit"): стрик - дни с последнего срыва,
+// напоминаний нет, вместо выполнений записываются срывы
+type RelapseServiceClient interface {
+	// LogRelapse записывает срыв и обнуляет текущий стрик
+	LogRelapse(ctx context.Context, in *LogRelapseRequest, opts ...grpc.CallOption) (*LogRelapseResponse, error)
+	// ListRelapses получает срывы привычки
+	ListRelapses(ctx context.Context, in *ListRelapsesRequest, opts ...grpc.CallOption) (*ListRelapsesResponse, error)
+	// GetRelapseStats получает самую длинную чистую серию и частоту срывов
+	GetRelapseStats(ctx context.Context, in *GetRelapseStatsRequest, opts ...grpc.CallOption) (*GetRelapseStatsResponse, error)
+}
+
+type relapseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelapseServiceClient(cc grpc.ClientConnInterface) RelapseServiceClient {
+	return &relapseServiceClient{cc}
+}
+
+func (c *relapseServiceClient) LogRelapse(ctx context.Context, in *LogRelapseRequest, opts ...grpc.CallOption) (*LogRelapseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogRelapseResponse)
+	err := c.cc.Invoke(ctx, RelapseService_LogRelapse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relapseServiceClient) ListRelapses(ctx context.Context, in *ListRelapsesRequest, opts ...grpc.CallOption) (*ListRelapsesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRelapsesResponse)
+	err := c.cc.Invoke(ctx, RelapseService_ListRelapses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relapseServiceClient) GetRelapseStats(ctx context.Context, in *GetRelapseStatsRequest, opts ...grpc.CallOption) (*GetRelapseStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelapseStatsResponse)
+	err := c.cc.Invoke(ctx, RelapseService_GetRelapseStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelapseServiceServer is the server API for RelapseService service.
+// All implementations must embed UnimplementedRelapseServiceServer
+// for forward compatibility.
+//
+// RelapseService для привычек-отказов (frequency "quit"): стрик - дни с последнего срыва,
+// напоминаний нет, вместо выполнений записываются срывы
+type RelapseServiceServer interface {
+	// LogRelapse записывает срыв и обнуляет текущий стрик
+	LogRelapse(context.Context, *LogRelapseRequest) (*LogRelapseResponse, error)
+	// ListRelapses получает срывы привычки
+	ListRelapses(context.Context, *ListRelapsesRequest) (*ListRelapsesResponse, error)
+	// GetRelapseStats получает самую длинную чистую серию и частоту срывов
+	GetRelapseStats(context.Context, *GetRelapseStatsRequest) (*GetRelapseStatsResponse, error)
+	mustEmbedUnimplementedRelapseServiceServer()
+}
+
+// UnimplementedRelapseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRelapseServiceServer struct{}
+
+func (UnimplementedRelapseServiceServer) LogRelapse(context.Context, *LogRelapseRequest) (*LogRelapseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogRelapse not implemented")
+}
+func (UnimplementedRelapseServiceServer) ListRelapses(context.Context, *ListRelapsesRequest) (*ListRelapsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelapses not implemented")
+}
+func (UnimplementedRelapseServiceServer) GetRelapseStats(context.Context, *GetRelapseStatsRequest) (*GetRelapseStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelapseStats not implemented")
+}
+func (UnimplementedRelapseServiceServer) mustEmbedUnimplementedRelapseServiceServer() {}
+func (UnimplementedRelapseServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeRelapseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelapseServiceServer will
+// result in compilation errors.
+type UnsafeRelapseServiceServer interface {
+	mustEmbedUnimplementedRelapseServiceServer()
+}
+
+func RegisterRelapseServiceServer(s grpc.ServiceRegistrar, srv RelapseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRelapseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RelapseService_ServiceDesc, srv)
+}
+
+func _RelapseService_LogRelapse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogRelapseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelapseServiceServer).LogRelapse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelapseService_LogRelapse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelapseServiceServer).LogRelapse(ctx, req.(*LogRelapseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelapseService_ListRelapses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelapsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelapseServiceServer).ListRelapses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelapseService_ListRelapses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelapseServiceServer).ListRelapses(ctx, req.(*ListRelapsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelapseService_GetRelapseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelapseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelapseServiceServer).GetRelapseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelapseService_GetRelapseStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelapseServiceServer).GetRelapseStats(ctx, req.(*GetRelapseStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RelapseService_ServiceDesc is the grpc.ServiceDesc for RelapseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelapseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hobbits.api.v1.RelapseService",
+	HandlerType: (*RelapseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LogRelapse",
+			Handler:    _RelapseService_LogRelapse_Handler,
+		},
+		{
+			MethodName: "ListRelapses",
+			Handler:    _RelapseService_ListRelapses_Handler,
+		},
+		{
+			MethodName: "GetRelapseStats",
+			Handler:    _RelapseService_GetRelapseStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "relapse_service.proto",
+}
@@ -0,0 +1,368 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: streak_freeze_service.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FrozenDay пропущенный день, закрытый заморозкой
+type FrozenDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	FrozenAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrozenDay) Reset() {
+	*x = FrozenDay{}
+	mi := &file_streak_freeze_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrozenDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrozenDay) ProtoMessage() {}
+
+func (x *FrozenDay) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_freeze_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrozenDay.ProtoReflect.Descriptor instead.
+func (*FrozenDay) Descriptor() ([]byte, []int) {
+	return file_streak_freeze_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FrozenDay) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *FrozenDay) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *FrozenDay) GetFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return nil
+}
+
+type GetFreezeBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFreezeBalanceRequest) Reset() {
+	*x = GetFreezeBalanceRequest{}
+	mi := &file_streak_freeze_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFreezeBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreezeBalanceRequest) ProtoMessage() {}
+
+func (x *GetFreezeBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_freeze_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreezeBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetFreezeBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_streak_freeze_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFreezeBalanceRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetFreezeBalanceResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Tokens          int32                  `protobuf:"varint,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	MaxEarnedTokens int32                  `protobuf:"varint,2,opt,name=max_earned_tokens,json=maxEarnedTokens,proto3" json:"max_earned_tokens,omitempty"` // больше заработать нельзя, выданные вручную не ограничены
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetFreezeBalanceResponse) Reset() {
+	*x = GetFreezeBalanceResponse{}
+	mi := &file_streak_freeze_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFreezeBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreezeBalanceResponse) ProtoMessage() {}
+
+func (x *GetFreezeBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_freeze_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreezeBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetFreezeBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_streak_freeze_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFreezeBalanceResponse) GetTokens() int32 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *GetFreezeBalanceResponse) GetMaxEarnedTokens() int32 {
+	if x != nil {
+		return x.MaxEarnedTokens
+	}
+	return 0
+}
+
+type ListFrozenDaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HabitId       int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"` // 0 - все привычки
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                    // по умолчанию и максимум 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFrozenDaysRequest) Reset() {
+	*x = ListFrozenDaysRequest{}
+	mi := &file_streak_freeze_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFrozenDaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFrozenDaysRequest) ProtoMessage() {}
+
+func (x *ListFrozenDaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_freeze_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFrozenDaysRequest.ProtoReflect.Descriptor instead.
+func (*ListFrozenDaysRequest) Descriptor() ([]byte, []int) {
+	return file_streak_freeze_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFrozenDaysRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListFrozenDaysRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *ListFrozenDaysRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFrozenDaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*FrozenDay           `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFrozenDaysResponse) Reset() {
+	*x = ListFrozenDaysResponse{}
+	mi := &file_streak_freeze_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFrozenDaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFrozenDaysResponse) ProtoMessage() {}
+
+func (x *ListFrozenDaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streak_freeze_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFrozenDaysResponse.ProtoReflect.Descriptor instead.
+func (*ListFrozenDaysResponse) Descriptor() ([]byte, []int) {
+	return file_streak_freeze_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListFrozenDaysResponse) GetDays() []*FrozenDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+var File_streak_freeze_service_proto protoreflect.FileDescriptor
+
+const file_streak_freeze_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1bstreak_freeze_service.proto\x12\x0ehobbits.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8f\x01\n" +
+	"\tFrozenDay\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x127\n" +
+	"\tfrozen_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bfrozenAt\"2\n" +
+	"\x17GetFreezeBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"^\n" +
+	"\x18GetFreezeBalanceResponse\x12\x16\n" +
+	"\x06tokens\x18\x01 \x01(\x05R\x06tokens\x12*\n" +
+	"\x11max_earned_tokens\x18\x02 \x01(\x05R\x0fmaxEarnedTokens\"a\n" +
+	"\x15ListFrozenDaysRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"G\n" +
+	"\x16ListFrozenDaysResponse\x12-\n" +
+	"\x04days\x18\x01 \x03(\v2\x19.hobbits.api.v1.FrozenDayR\x04days2\xdd\x01\n" +
+	"\x13StreakFreezeService\x12e\n" +
+	"\x10GetFreezeBalance\x12'.hobbits.api.v1.GetFreezeBalanceRequest\x1a(.hobbits.api.v1.GetFreezeBalanceResponse\x12_\n" +
+	"\x0eListFrozenDays\x12%.hobbits.api.v1.ListFrozenDaysRequest\x1a&.hobbits.api.v1.ListFrozenDaysResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
+
+var (
+	file_streak_freeze_service_proto_rawDescOnce sync.Once
+	file_streak_freeze_service_proto_rawDescData []byte
+)
+
+func file_streak_freeze_service_proto_rawDescGZIP() []byte {
+	file_streak_freeze_service_proto_rawDescOnce.Do(func() {
+		file_streak_freeze_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_streak_freeze_service_proto_rawDesc), len(file_streak_freeze_service_proto_rawDesc)))
+	})
+	return file_streak_freeze_service_proto_rawDescData
+}
+
+var file_streak_freeze_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_streak_freeze_service_proto_goTypes = []any{
+	(*FrozenDay)(nil),                // 0: hobbits.api.v1.FrozenDay
+	(*GetFreezeBalanceRequest)(nil),  // 1: hobbits.api.v1.GetFreezeBalanceRequest
+	(*GetFreezeBalanceResponse)(nil), // 2: hobbits.api.v1.GetFreezeBalanceResponse
+	(*ListFrozenDaysRequest)(nil),    // 3: hobbits.api.v1.ListFrozenDaysRequest
+	(*ListFrozenDaysResponse)(nil),   // 4: hobbits.api.v1.ListFrozenDaysResponse
+	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
+}
+var file_streak_freeze_service_proto_depIdxs = []int32{
+	5, // 0: hobbits.api.v1.FrozenDay.date:type_name -> google.protobuf.Timestamp
+	5, // 1: hobbits.api.v1.FrozenDay.frozen_at:type_name -> google.protobuf.Timestamp
+	0, // 2: hobbits.api.v1.ListFrozenDaysResponse.days:type_name -> hobbits.api.v1.FrozenDay
+	1, // 3: hobbits.api.v1.StreakFreezeService.GetFreezeBalance:input_type -> hobbits.api.v1.GetFreezeBalanceRequest
+	3, // 4: hobbits.api.v1.StreakFreezeService.ListFrozenDays:input_type -> hobbits.api.v1.ListFrozenDaysRequest
+	2, // 5: hobbits.api.v1.StreakFreezeService.GetFreezeBalance:output_type -> hobbits.api.v1.GetFreezeBalanceResponse
+	4, // 6: hobbits.api.v1.StreakFreezeService.ListFrozenDays:output_type -> hobbits.api.v1.ListFrozenDaysResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_streak_freeze_service_proto_init() }
+func file_streak_freeze_service_proto_init() {
+	if File_streak_freeze_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_streak_freeze_service_proto_rawDesc), len(file_streak_freeze_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_streak_freeze_service_proto_goTypes,
+		DependencyIndexes: file_streak_freeze_service_proto_depIdxs,
+		MessageInfos:      file_streak_freeze_service_proto_msgTypes,
+	}.Build()
+	File_streak_freeze_service_proto = out.File
+	file_streak_freeze_service_proto_goTypes = nil
+	file_streak_freeze_service_proto_depIdxs = nil
+}
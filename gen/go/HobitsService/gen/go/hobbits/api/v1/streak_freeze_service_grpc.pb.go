@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: streak_freeze_service.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StreakFreezeService_GetFreezeBalance_FullMethodName = "/hobbits.api.v1.StreakFreezeService/GetFreezeBalance"
+	StreakFreezeService_ListFrozenDays_FullMethodName   = "/hobbits.api.v1.StreakFreezeService/ListFrozenDays"
+)
+
+// StreakFreezeServiceClient is the client API for StreakFreezeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StreakFreezeService для заморозок стрика: заморозка тратится автоматически
+// вместо сброса стрика за пропущенный день
+type StreakFreezeServiceClient interface {
+	// GetFreezeBalance получает число заморозок пользователя
+	GetFreezeBalance(ctx context.Context, in *GetFreezeBalanceRequest, opts ...grpc.CallOption) (*GetFreezeBalanceResponse, error)
+	// ListFrozenDays получает пропуски, закрытые заморозкой
+	ListFrozenDays(ctx context.Context, in *ListFrozenDaysRequest, opts ...grpc.CallOption) (*ListFrozenDaysResponse, error)
+}
+
+type streakFreezeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreakFreezeServiceClient(cc grpc.ClientConnInterface) StreakFreezeServiceClient {
+	return &streakFreezeServiceClient{cc}
+}
+
+func (c *streakFreezeServiceClient) GetFreezeBalance(ctx context.Context, in *GetFreezeBalanceRequest, opts ...grpc.CallOption) (*GetFreezeBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFreezeBalanceResponse)
+	err := c.cc.Invoke(ctx, StreakFreezeService_GetFreezeBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streakFreezeServiceClient) ListFrozenDays(ctx context.Context, in *ListFrozenDaysRequest, opts ...grpc.CallOption) (*ListFrozenDaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFrozenDaysResponse)
+	err := c.cc.Invoke(ctx, StreakFreezeService_ListFrozenDays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StreakFreezeServiceServer is the server API for StreakFreezeService service.
+// All implementations must embed UnimplementedStreakFreezeServiceServer
+// for forward compatibility.
+//
+// StreakFreezeService для заморозок стрика: заморозка тратится автоматически
+// вместо сброса стрика за пропущенный день
+type StreakFreezeServiceServer interface {
+	// GetFreezeBalance получает число заморозок пользователя
+	GetFreezeBalance(context.Context, *GetFreezeBalanceRequest) (*GetFreezeBalanceResponse, error)
+	// ListFrozenDays получает пропуски, закрытые заморозкой
+	ListFrozenDays(context.Context, *ListFrozenDaysRequest) (*ListFrozenDaysResponse, error)
+	mustEmbedUnimplementedStreakFreezeServiceServer()
+}
+
+// UnimplementedStreakFreezeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStreakFreezeServiceServer struct{}
+
+func (UnimplementedStreakFreezeServiceServer) GetFreezeBalance(context.Context, *GetFreezeBalanceRequest) (*GetFreezeBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreezeBalance not implemented")
+}
+func (UnimplementedStreakFreezeServiceServer) ListFrozenDays(context.Context, *ListFrozenDaysRequest) (*ListFrozenDaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFrozenDays not implemented")
+}
+func (UnimplementedStreakFreezeServiceServer) mustEmbedUnimplementedStreakFreezeServiceServer() {}
+func (UnimplementedStreakFreezeServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeStreakFreezeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreakFreezeServiceServer will
+// result in compilation errors.
+type UnsafeStreakFreezeServiceServer interface {
+	mustEmbedUnimplementedStreakFreezeServiceServer()
+}
+
+func RegisterStreakFreezeServiceServer(s grpc.ServiceRegistrar, srv StreakFreezeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStreakFreezeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StreakFreezeService_ServiceDesc, srv)
+}
+
+func _StreakFreezeService_GetFreezeBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreezeBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreakFreezeServiceServer).GetFreezeBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreakFreezeService_GetFreezeBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreakFreezeServiceServer).GetFreezeBalance(ctx, req.(*GetFreezeBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreakFreezeService_ListFrozenDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFrozenDaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreakFreezeServiceServer).ListFrozenDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreakFreezeService_ListFrozenDays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreakFreezeServiceServer).ListFrozenDays(ctx, req.(*ListFrozenDaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StreakFreezeService_ServiceDesc is the grpc.ServiceDesc for StreakFreezeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StreakFreezeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hobbits.api.v1.StreakFreezeService",
+	HandlerType: (*StreakFreezeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFreezeBalance",
+			Handler:    _StreakFreezeService_GetFreezeBalance_Handler,
+		},
+		{
+			MethodName: "ListFrozenDays",
+			Handler:    _StreakFreezeService_ListFrozenDays_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "streak_freeze_service.proto",
+}
@@ -0,0 +1,507 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: timer_service.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TimerSession сессия таймера привычки
+type TimerSession struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HabitId        int32                  `protobuf:"varint,2,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId         int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	StoppedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"` // пусто у запущенного таймера
+	ElapsedSeconds int64                  `protobuf:"varint,6,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TimerSession) Reset() {
+	*x = TimerSession{}
+	mi := &file_timer_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimerSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerSession) ProtoMessage() {}
+
+func (x *TimerSession) ProtoReflect() protoreflect.Message {
+	mi := &file_timer_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerSession.ProtoReflect.Descriptor instead.
+func (*TimerSession) Descriptor() ([]byte, []int) {
+	return file_timer_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimerSession) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimerSession) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *TimerSession) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TimerSession) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TimerSession) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+func (x *TimerSession) GetElapsedSeconds() int64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+type StartTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HabitId       int32                  `protobuf:"varint,1,opt,name=habit_id,json=habitId,proto3" json:"habit_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTimerRequest) Reset() {
+	*x = StartTimerRequest{}
+	mi := &file_timer_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTimerRequest) ProtoMessage() {}
+
+func (x *StartTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timer_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTimerRequest.ProtoReflect.Descriptor instead.
+func (*StartTimerRequest) Descriptor() ([]byte, []int) {
+	return file_timer_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartTimerRequest) GetHabitId() int32 {
+	if x != nil {
+		return x.HabitId
+	}
+	return 0
+}
+
+func (x *StartTimerRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type StartTimerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timer         *TimerSession          `protobuf:"bytes,1,opt,name=timer,proto3" json:"timer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTimerResponse) Reset() {
+	*x = StartTimerResponse{}
+	mi := &file_timer_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTimerResponse) ProtoMessage() {}
+
+func (x *StartTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timer_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTimerResponse.ProtoReflect.Descriptor instead.
+func (*StartTimerResponse) Descriptor() ([]byte, []int) {
+	return file_timer_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartTimerResponse) GetTimer() *TimerSession {
+	if x != nil {
+		return x.Timer
+	}
+	return nil
+}
+
+type StopTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTimerRequest) Reset() {
+	*x = StopTimerRequest{}
+	mi := &file_timer_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTimerRequest) ProtoMessage() {}
+
+func (x *StopTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timer_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTimerRequest.ProtoReflect.Descriptor instead.
+func (*StopTimerRequest) Descriptor() ([]byte, []int) {
+	return file_timer_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StopTimerRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type StopTimerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timer         *TimerSession          `protobuf:"bytes,1,opt,name=timer,proto3" json:"timer,omitempty"`
+	DayMinutes    float64                `protobuf:"fixed64,2,opt,name=day_minutes,json=dayMinutes,proto3" json:"day_minutes,omitempty"`      // минут за день остановки
+	DayCompleted  bool                   `protobuf:"varint,3,opt,name=day_completed,json=dayCompleted,proto3" json:"day_completed,omitempty"` // дневной минимум набран
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTimerResponse) Reset() {
+	*x = StopTimerResponse{}
+	mi := &file_timer_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTimerResponse) ProtoMessage() {}
+
+func (x *StopTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timer_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTimerResponse.ProtoReflect.Descriptor instead.
+func (*StopTimerResponse) Descriptor() ([]byte, []int) {
+	return file_timer_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StopTimerResponse) GetTimer() *TimerSession {
+	if x != nil {
+		return x.Timer
+	}
+	return nil
+}
+
+func (x *StopTimerResponse) GetDayMinutes() float64 {
+	if x != nil {
+		return x.DayMinutes
+	}
+	return 0
+}
+
+func (x *StopTimerResponse) GetDayCompleted() bool {
+	if x != nil {
+		return x.DayCompleted
+	}
+	return false
+}
+
+type GetActiveTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveTimerRequest) Reset() {
+	*x = GetActiveTimerRequest{}
+	mi := &file_timer_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveTimerRequest) ProtoMessage() {}
+
+func (x *GetActiveTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timer_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveTimerRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveTimerRequest) Descriptor() ([]byte, []int) {
+	return file_timer_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetActiveTimerRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetActiveTimerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timer         *TimerSession          `protobuf:"bytes,1,opt,name=timer,proto3" json:"timer,omitempty"` // пусто, если таймер не запущен
+	Running       bool                   `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveTimerResponse) Reset() {
+	*x = GetActiveTimerResponse{}
+	mi := &file_timer_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveTimerResponse) ProtoMessage() {}
+
+func (x *GetActiveTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timer_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveTimerResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveTimerResponse) Descriptor() ([]byte, []int) {
+	return file_timer_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetActiveTimerResponse) GetTimer() *TimerSession {
+	if x != nil {
+		return x.Timer
+	}
+	return nil
+}
+
+func (x *GetActiveTimerResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+var File_timer_service_proto protoreflect.FileDescriptor
+
+const file_timer_service_proto_rawDesc = "" +
+	"\n" +
+	"\x13timer_service.proto\x12\x0ehobbits.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x01\n" +
+	"\fTimerSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bhabit_id\x18\x02 \x01(\x05R\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"stopped_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstoppedAt\x12'\n" +
+	"\x0felapsed_seconds\x18\x06 \x01(\x03R\x0eelapsedSeconds\"G\n" +
+	"\x11StartTimerRequest\x12\x19\n" +
+	"\bhabit_id\x18\x01 \x01(\x05R\ahabitId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"H\n" +
+	"\x12StartTimerResponse\x122\n" +
+	"\x05timer\x18\x01 \x01(\v2\x1c.hobbits.api.v1.TimerSessionR\x05timer\"+\n" +
+	"\x10StopTimerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\x8d\x01\n" +
+	"\x11StopTimerResponse\x122\n" +
+	"\x05timer\x18\x01 \x01(\v2\x1c.hobbits.api.v1.TimerSessionR\x05timer\x12\x1f\n" +
+	"\vday_minutes\x18\x02 \x01(\x01R\n" +
+	"dayMinutes\x12#\n" +
+	"\rday_completed\x18\x03 \x01(\bR\fdayCompleted\"0\n" +
+	"\x15GetActiveTimerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"f\n" +
+	"\x16GetActiveTimerResponse\x122\n" +
+	"\x05timer\x18\x01 \x01(\v2\x1c.hobbits.api.v1.TimerSessionR\x05timer\x12\x18\n" +
+	"\arunning\x18\x02 \x01(\bR\arunning2\x96\x02\n" +
+	"\fTimerService\x12S\n" +
+	"\n" +
+	"StartTimer\x12!.hobbits.api.v1.StartTimerRequest\x1a\".hobbits.api.v1.StartTimerResponse\x12P\n" +
+	"\tStopTimer\x12 .hobbits.api.v1.StopTimerRequest\x1a!.hobbits.api.v1.StopTimerResponse\x12_\n" +
+	"\x0eGetActiveTimer\x12%.hobbits.api.v1.GetActiveTimerRequest\x1a&.hobbits.api.v1.GetActiveTimerResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
+
+var (
+	file_timer_service_proto_rawDescOnce sync.Once
+	file_timer_service_proto_rawDescData []byte
+)
+
+func file_timer_service_proto_rawDescGZIP() []byte {
+	file_timer_service_proto_rawDescOnce.Do(func() {
+		file_timer_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_timer_service_proto_rawDesc), len(file_timer_service_proto_rawDesc)))
+	})
+	return file_timer_service_proto_rawDescData
+}
+
+var file_timer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_timer_service_proto_goTypes = []any{
+	(*TimerSession)(nil),           // 0: hobbits.api.v1.TimerSession
+	(*StartTimerRequest)(nil),      // 1: hobbits.api.v1.StartTimerRequest
+	(*StartTimerResponse)(nil),     // 2: hobbits.api.v1.StartTimerResponse
+	(*StopTimerRequest)(nil),       // 3: hobbits.api.v1.StopTimerRequest
+	(*StopTimerResponse)(nil),      // 4: hobbits.api.v1.StopTimerResponse
+	(*GetActiveTimerRequest)(nil),  // 5: hobbits.api.v1.GetActiveTimerRequest
+	(*GetActiveTimerResponse)(nil), // 6: hobbits.api.v1.GetActiveTimerResponse
+	(*timestamppb.Timestamp)(nil),  // 7: google.protobuf.Timestamp
+}
+var file_timer_service_proto_depIdxs = []int32{
+	7, // 0: hobbits.api.v1.TimerSession.started_at:type_name -> google.protobuf.Timestamp
+	7, // 1: hobbits.api.v1.TimerSession.stopped_at:type_name -> google.protobuf.Timestamp
+	0, // 2: hobbits.api.v1.StartTimerResponse.timer:type_name -> hobbits.api.v1.TimerSession
+	0, // 3: hobbits.api.v1.StopTimerResponse.timer:type_name -> hobbits.api.v1.TimerSession
+	0, // 4: hobbits.api.v1.GetActiveTimerResponse.timer:type_name -> hobbits.api.v1.TimerSession
+	1, // 5: hobbits.api.v1.TimerService.StartTimer:input_type -> hobbits.api.v1.StartTimerRequest
+	3, // 6: hobbits.api.v1.TimerService.StopTimer:input_type -> hobbits.api.v1.StopTimerRequest
+	5, // 7: hobbits.api.v1.TimerService.GetActiveTimer:input_type -> hobbits.api.v1.GetActiveTimerRequest
+	2, // 8: hobbits.api.v1.TimerService.StartTimer:output_type -> hobbits.api.v1.StartTimerResponse
+	4, // 9: hobbits.api.v1.TimerService.StopTimer:output_type -> hobbits.api.v1.StopTimerResponse
+	6, // 10: hobbits.api.v1.TimerService.GetActiveTimer:output_type -> hobbits.api.v1.GetActiveTimerResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_timer_service_proto_init() }
+func file_timer_service_proto_init() {
+	if File_timer_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_timer_service_proto_rawDesc), len(file_timer_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_timer_service_proto_goTypes,
+		DependencyIndexes: file_timer_service_proto_depIdxs,
+		MessageInfos:      file_timer_service_proto_msgTypes,
+	}.Build()
+	File_timer_service_proto = out.File
+	file_timer_service_proto_goTypes = nil
+	file_timer_service_proto_depIdxs = nil
+}
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: timer_service.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimerService_StartTimer_FullMethodName     = "/hobbits.api.v1.TimerService/StartTimer"
+	TimerService_StopTimer_FullMethodName      = "/hobbits.api.v1.TimerService/StopTimer"
+	TimerService_GetActiveTimer_FullMethodName = "/hobbits.api.v1.TimerService/GetActiveTimer"
+)
+
+// TimerServiceClient is the client API for TimerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TimerService для привычек с дневной целью в минутах (unit "min"): время засекается таймером,
+// лог с накопленной длительностью создается, когда набран дневной минимум
+type TimerServiceClient interface {
+	// StartTimer запускает таймер привычки; у пользователя может быть запущен только один таймер
+	StartTimer(ctx context.Context, in *StartTimerRequest, opts ...grpc.CallOption) (*StartTimerResponse, error)
+	// StopTimer останавливает таймер и засчитывает время; таймер через начало дня делится по дням
+	StopTimer(ctx context.Context, in *StopTimerRequest, opts ...grpc.CallOption) (*StopTimerResponse, error)
+	// GetActiveTimer получает запущенный таймер пользователя
+	GetActiveTimer(ctx context.Context, in *GetActiveTimerRequest, opts ...grpc.CallOption) (*GetActiveTimerResponse, error)
+}
+
+type timerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimerServiceClient(cc grpc.ClientConnInterface) TimerServiceClient {
+	return &timerServiceClient{cc}
+}
+
+func (c *timerServiceClient) StartTimer(ctx context.Context, in *StartTimerRequest, opts ...grpc.CallOption) (*StartTimerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartTimerResponse)
+	err := c.cc.Invoke(ctx, TimerService_StartTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timerServiceClient) StopTimer(ctx context.Context, in *StopTimerRequest, opts ...grpc.CallOption) (*StopTimerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopTimerResponse)
+	err := c.cc.Invoke(ctx, TimerService_StopTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timerServiceClient) GetActiveTimer(ctx context.Context, in *GetActiveTimerRequest, opts ...grpc.CallOption) (*GetActiveTimerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveTimerResponse)
+	err := c.cc.Invoke(ctx, TimerService_GetActiveTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimerServiceServer is the server API for TimerService service.
+// All implementations must embed UnimplementedTimerServiceServer
+// for forward compatibility.
+//
+// TimerService для привычек с дневной целью в минутах (unit "min"): время засекается таймером,
+// лог с накопленной длительностью создается, когда набран дневной минимум
+type TimerServiceServer interface {
+	// StartTimer запускает таймер привычки; у пользователя может быть запущен только один таймер
+	StartTimer(context.Context, *StartTimerRequest) (*StartTimerResponse, error)
+	// StopTimer останавливает таймер и засчитывает время; таймер через начало дня делится по дням
+	StopTimer(context.Context, *StopTimerRequest) (*StopTimerResponse, error)
+	// GetActiveTimer получает запущенный таймер пользователя
+	GetActiveTimer(context.Context, *GetActiveTimerRequest) (*GetActiveTimerResponse, error)
+	mustEmbedUnimplementedTimerServiceServer()
+}
+
+// UnimplementedTimerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimerServiceServer struct{}
+
+func (UnimplementedTimerServiceServer) StartTimer(context.Context, *StartTimerRequest) (*StartTimerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTimer not implemented")
+}
+func (UnimplementedTimerServiceServer) StopTimer(context.Context, *StopTimerRequest) (*StopTimerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopTimer not implemented")
+}
+func (UnimplementedTimerServiceServer) GetActiveTimer(context.Context, *GetActiveTimerRequest) (*GetActiveTimerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveTimer not implemented")
+}
+func (UnimplementedTimerServiceServer) mustEmbedUnimplementedTimerServiceServer() {}
+func (UnimplementedTimerServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeTimerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimerServiceServer will
+// result in compilation errors.
+type UnsafeTimerServiceServer interface {
+	mustEmbedUnimplementedTimerServiceServer()
+}
+
+func RegisterTimerServiceServer(s grpc.ServiceRegistrar, srv TimerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimerService_ServiceDesc, srv)
+}
+
+func _TimerService_StartTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimerServiceServer).StartTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimerService_StartTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimerServiceServer).StartTimer(ctx, req.(*StartTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimerService_StopTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimerServiceServer).StopTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimerService_StopTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimerServiceServer).StopTimer(ctx, req.(*StopTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimerService_GetActiveTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimerServiceServer).GetActiveTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimerService_GetActiveTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimerServiceServer).GetActiveTimer(ctx, req.(*GetActiveTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimerService_ServiceDesc is the grpc.ServiceDesc for TimerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hobbits.api.v1.TimerService",
+	HandlerType: (*TimerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartTimer",
+			Handler:    _TimerService_StartTimer_Handler,
+		},
+		{
+			MethodName: "StopTimer",
+			Handler:    _TimerService_StopTimer_Handler,
+		},
+		{
+			MethodName: "GetActiveTimer",
+			Handler:    _TimerService_GetActiveTimer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timer_service.proto",
+}
@@ -28,6 +28,7 @@ type GetOrCreateUserRequest struct {
 	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
 	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
 	LanguageCode  string                 `protobuf:"bytes,5,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA, применяется при создании; пусто - UTC
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,6 +98,13 @@ func (x *GetOrCreateUserRequest) GetLanguageCode() string {
 	return ""
 }
 
+func (x *GetOrCreateUserRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 type GetOrCreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -244,6 +252,7 @@ type UpdateUserRequest struct {
 	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
 	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
 	LanguageCode  string                 `protobuf:"bytes,5,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA; пусто - не менять
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -313,6 +322,13 @@ func (x *UpdateUserRequest) GetLanguageCode() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -357,11 +373,115 @@ func (x *UpdateUserResponse) GetUser() *User {
 	return nil
 }
 
+type SetDayBoundaryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DayStartHour    int32                  `protobuf:"varint,2,opt,name=day_start_hour,json=dayStartHour,proto3" json:"day_start_hour,omitempty"`          // 0-23, например 4 - день начинается в 04:00
+	LogGraceMinutes int32                  `protobuf:"varint,3,opt,name=log_grace_minutes,json=logGraceMinutes,proto3" json:"log_grace_minutes,omitempty"` // 0-720
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetDayBoundaryRequest) Reset() {
+	*x = SetDayBoundaryRequest{}
+	mi := &file_user_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDayBoundaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDayBoundaryRequest) ProtoMessage() {}
+
+func (x *SetDayBoundaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDayBoundaryRequest.ProtoReflect.Descriptor instead.
+func (*SetDayBoundaryRequest) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetDayBoundaryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetDayBoundaryRequest) GetDayStartHour() int32 {
+	if x != nil {
+		return x.DayStartHour
+	}
+	return 0
+}
+
+func (x *SetDayBoundaryRequest) GetLogGraceMinutes() int32 {
+	if x != nil {
+		return x.LogGraceMinutes
+	}
+	return 0
+}
+
+type SetDayBoundaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDayBoundaryResponse) Reset() {
+	*x = SetDayBoundaryResponse{}
+	mi := &file_user_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDayBoundaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDayBoundaryResponse) ProtoMessage() {}
+
+func (x *SetDayBoundaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDayBoundaryResponse.ProtoReflect.Descriptor instead.
+func (*SetDayBoundaryResponse) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetDayBoundaryResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 var File_user_service_proto protoreflect.FileDescriptor
 
 const file_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x12user_service.proto\x12\x0ehobbits.api.v1\x1a\fcommon.proto\"\xb6\x01\n" +
+	"\x12user_service.proto\x12\x0ehobbits.api.v1\x1a\fcommon.proto\"\xd2\x01\n" +
 	"\x16GetOrCreateUserRequest\x12\x1f\n" +
 	"\vtelegram_id\x18\x01 \x01(\x03R\n" +
 	"telegramId\x12\x1d\n" +
@@ -369,28 +489,37 @@ const file_user_service_proto_rawDesc = "" +
 	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
 	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x1a\n" +
 	"\busername\x18\x04 \x01(\tR\busername\x12#\n" +
-	"\rlanguage_code\x18\x05 \x01(\tR\flanguageCode\"]\n" +
+	"\rlanguage_code\x18\x05 \x01(\tR\flanguageCode\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\"]\n" +
 	"\x17GetOrCreateUserResponse\x12(\n" +
 	"\x04user\x18\x01 \x01(\v2\x14.hobbits.api.v1.UserR\x04user\x12\x18\n" +
 	"\acreated\x18\x02 \x01(\bR\acreated\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\";\n" +
 	"\x0fGetUserResponse\x12(\n" +
-	"\x04user\x18\x01 \x01(\v2\x14.hobbits.api.v1.UserR\x04user\"\xa0\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.hobbits.api.v1.UserR\x04user\"\xbc\x01\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
 	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x1a\n" +
 	"\busername\x18\x04 \x01(\tR\busername\x12#\n" +
-	"\rlanguage_code\x18\x05 \x01(\tR\flanguageCode\">\n" +
+	"\rlanguage_code\x18\x05 \x01(\tR\flanguageCode\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\">\n" +
 	"\x12UpdateUserResponse\x12(\n" +
-	"\x04user\x18\x01 \x01(\v2\x14.hobbits.api.v1.UserR\x04user2\x92\x02\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.hobbits.api.v1.UserR\x04user\"y\n" +
+	"\x15SetDayBoundaryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12$\n" +
+	"\x0eday_start_hour\x18\x02 \x01(\x05R\fdayStartHour\x12*\n" +
+	"\x11log_grace_minutes\x18\x03 \x01(\x05R\x0flogGraceMinutes\"B\n" +
+	"\x16SetDayBoundaryResponse\x12(\n" +
+	"\x04user\x18\x01 \x01(\v2\x14.hobbits.api.v1.UserR\x04user2\xf3\x02\n" +
 	"\vUserService\x12b\n" +
 	"\x0fGetOrCreateUser\x12&.hobbits.api.v1.GetOrCreateUserRequest\x1a'.hobbits.api.v1.GetOrCreateUserResponse\x12J\n" +
 	"\aGetUser\x12\x1e.hobbits.api.v1.GetUserRequest\x1a\x1f.hobbits.api.v1.GetUserResponse\x12S\n" +
 	"\n" +
-	"UpdateUser\x12!.hobbits.api.v1.UpdateUserRequest\x1a\".hobbits.api.v1.UpdateUserResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
+	"UpdateUser\x12!.hobbits.api.v1.UpdateUserRequest\x1a\".hobbits.api.v1.UpdateUserResponse\x12_\n" +
+	"\x0eSetDayBoundary\x12%.hobbits.api.v1.SetDayBoundaryRequest\x1a&.hobbits.api.v1.SetDayBoundaryResponseB%Z#HobitsService/gen/go/hobbits/api/v1b\x06proto3"
 
 var (
 	file_user_service_proto_rawDescOnce sync.Once
@@ -404,7 +533,7 @@ func file_user_service_proto_rawDescGZIP() []byte {
 	return file_user_service_proto_rawDescData
 }
 
-var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_user_service_proto_goTypes = []any{
 	(*GetOrCreateUserRequest)(nil),  // 0: hobbits.api.v1.GetOrCreateUserRequest
 	(*GetOrCreateUserResponse)(nil), // 1: hobbits.api.v1.GetOrCreateUserResponse
@@ -412,23 +541,28 @@ var file_user_service_proto_goTypes = []any{
 	(*GetUserResponse)(nil),         // 3: hobbits.api.v1.GetUserResponse
 	(*UpdateUserRequest)(nil),       // 4: hobbits.api.v1.UpdateUserRequest
 	(*UpdateUserResponse)(nil),      // 5: hobbits.api.v1.UpdateUserResponse
-	(*User)(nil),                    // 6: hobbits.api.v1.User
+	(*SetDayBoundaryRequest)(nil),   // 6: hobbits.api.v1.SetDayBoundaryRequest
+	(*SetDayBoundaryResponse)(nil),  // 7: hobbits.api.v1.SetDayBoundaryResponse
+	(*User)(nil),                    // 8: hobbits.api.v1.User
 }
 var file_user_service_proto_depIdxs = []int32{
-	6, // 0: hobbits.api.v1.GetOrCreateUserResponse.user:type_name -> hobbits.api.v1.User
-	6, // 1: hobbits.api.v1.GetUserResponse.user:type_name -> hobbits.api.v1.User
-	6, // 2: hobbits.api.v1.UpdateUserResponse.user:type_name -> hobbits.api.v1.User
-	0, // 3: hobbits.api.v1.UserService.GetOrCreateUser:input_type -> hobbits.api.v1.GetOrCreateUserRequest
-	2, // 4: hobbits.api.v1.UserService.GetUser:input_type -> hobbits.api.v1.GetUserRequest
-	4, // 5: hobbits.api.v1.UserService.UpdateUser:input_type -> hobbits.api.v1.UpdateUserRequest
-	1, // 6: hobbits.api.v1.UserService.GetOrCreateUser:output_type -> hobbits.api.v1.GetOrCreateUserResponse
-	3, // 7: hobbits.api.v1.UserService.GetUser:output_type -> hobbits.api.v1.GetUserResponse
-	5, // 8: hobbits.api.v1.UserService.UpdateUser:output_type -> hobbits.api.v1.UpdateUserResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8, // 0: hobbits.api.v1.GetOrCreateUserResponse.user:type_name -> hobbits.api.v1.User
+	8, // 1: hobbits.api.v1.GetUserResponse.user:type_name -> hobbits.api.v1.User
+	8, // 2: hobbits.api.v1.UpdateUserResponse.user:type_name -> hobbits.api.v1.User
+	8, // 3: hobbits.api.v1.SetDayBoundaryResponse.user:type_name -> hobbits.api.v1.User
+	0, // 4: hobbits.api.v1.UserService.GetOrCreateUser:input_type -> hobbits.api.v1.GetOrCreateUserRequest
+	2, // 5: hobbits.api.v1.UserService.GetUser:input_type -> hobbits.api.v1.GetUserRequest
+	4, // 6: hobbits.api.v1.UserService.UpdateUser:input_type -> hobbits.api.v1.UpdateUserRequest
+	6, // 7: hobbits.api.v1.UserService.SetDayBoundary:input_type -> hobbits.api.v1.SetDayBoundaryRequest
+	1, // 8: hobbits.api.v1.UserService.GetOrCreateUser:output_type -> hobbits.api.v1.GetOrCreateUserResponse
+	3, // 9: hobbits.api.v1.UserService.GetUser:output_type -> hobbits.api.v1.GetUserResponse
+	5, // 10: hobbits.api.v1.UserService.UpdateUser:output_type -> hobbits.api.v1.UpdateUserResponse
+	7, // 11: hobbits.api.v1.UserService.SetDayBoundary:output_type -> hobbits.api.v1.SetDayBoundaryResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_user_service_proto_init() }
@@ -443,7 +577,7 @@ func file_user_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_service_proto_rawDesc), len(file_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	UserService_GetOrCreateUser_FullMethodName = "/hobbits.api.v1.UserService/GetOrCreateUser"
 	UserService_GetUser_FullMethodName         = "/hobbits.api.v1.UserService/GetUser"
 	UserService_UpdateUser_FullMethodName      = "/hobbits.api.v1.UserService/UpdateUser"
+	UserService_SetDayBoundary_FullMethodName  = "/hobbits.api.v1.UserService/SetDayBoundary"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -36,6 +37,8 @@ type UserServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	// UpdateUser обновляет информацию пользователя
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	// SetDayBoundary устанавливает час начала дня и окно льготы для поздних отметок
+	SetDayBoundary(ctx context.Context, in *SetDayBoundaryRequest, opts ...grpc.CallOption) (*SetDayBoundaryResponse, error)
 }
 
 type userServiceClient struct {
@@ -76,6 +79,16 @@ func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) SetDayBoundary(ctx context.Context, in *SetDayBoundaryRequest, opts ...grpc.CallOption) (*SetDayBoundaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDayBoundaryResponse)
+	err := c.cc.Invoke(ctx, UserService_SetDayBoundary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -88,6 +101,8 @@ type UserServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	// UpdateUser обновляет информацию пользователя
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// SetDayBoundary устанавливает час начала дня и окно льготы для поздних отметок
+	SetDayBoundary(context.Context, *SetDayBoundaryRequest) (*SetDayBoundaryResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -107,6 +122,9 @@ func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest)
 func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedUserServiceServer) SetDayBoundary(context.Context, *SetDayBoundaryRequest) (*SetDayBoundaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDayBoundary not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -182,6 +200,24 @@ func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SetDayBoundary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDayBoundaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetDayBoundary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetDayBoundary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetDayBoundary(ctx, req.(*SetDayBoundaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +237,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _UserService_UpdateUser_Handler,
 		},
+		{
+			MethodName: "SetDayBoundary",
+			Handler:    _UserService_SetDayBoundary_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service.proto",
@@ -15,7 +15,12 @@ protoc --go_out="$GEN_DIR" --go-grpc_out="$GEN_DIR" \
   "$PROTO_DIR"/user_service.proto \
   "$PROTO_DIR"/habit_service.proto \
   "$PROTO_DIR"/log_service.proto \
-  "$PROTO_DIR"/reminder_service.proto
+  "$PROTO_DIR"/reminder_service.proto \
+  "$PROTO_DIR"/admin_service.proto \
+  "$PROTO_DIR"/pause_service.proto \
+  "$PROTO_DIR"/streak_freeze_service.proto \
+  "$PROTO_DIR"/relapse_service.proto \
+  "$PROTO_DIR"/timer_service.proto
 
 echo "Proto files generated successfully!"
 echo "Generated files are in: $GEN_DIR"
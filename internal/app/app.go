@@ -1,13 +1,20 @@
 package app
 
 import (
+	"fmt"
+
+	"HobitsService/internal/config"
 	"HobitsService/internal/delivery/grpc"
 	"HobitsService/internal/infrastructure/database"
+	"HobitsService/internal/infrastructure/events"
 	"HobitsService/internal/infrastructure/scheduler"
 	"HobitsService/internal/repository/postgres"
 	"HobitsService/internal/service"
 )
 
+// schedulerLockName имя advisory lock, за которое конкурируют реплики scheduler
+const schedulerLockName = "hobits:scheduler"
+
 // App содержит все зависимости приложения
 type App struct {
 	// Infrastructure
@@ -19,13 +26,27 @@ type App struct {
 	HabitLogRepository         *postgres.HabitLogRepository
 	HabitReminderRepository    *postgres.HabitReminderRepository
 	StreakResetQueueRepository *postgres.StreakResetQueueRepository
+	SchedulerRunRepository     *postgres.SchedulerRunRepository
+	JobRunRepository           *postgres.JobRunRepository
+	PauseRepository            *postgres.PauseRepository
+	StreakFreezeRepository     *postgres.StreakFreezeRepository
+	RelapseRepository          *postgres.RelapseRepository
+	TimerRepository            *postgres.TimerRepository
+	SkipRepository             *postgres.SkipRepository
+	ScheduleRevisionRepository *postgres.ScheduleRevisionRepository
+	HabitEventRepository       *postgres.HabitEventRepository
 
 	// Services
-	UserService        *service.UserService
-	HabitService       *service.HabitService
-	LogService         *service.LogService
-	ReminderService    *service.ReminderService
-	StreakResetService *service.StreakResetService
+	UserService         *service.UserService
+	HabitService        *service.HabitService
+	LogService          *service.LogService
+	ReminderService     *service.ReminderService
+	StreakResetService  *service.StreakResetService
+	PauseService        *service.PauseService
+	StreakFreezeService *service.StreakFreezeService
+	RelapseService      *service.RelapseService
+	TimerService        *service.TimerService
+	EventService        *service.EventService
 
 	// Delivery
 	GRPCServer *grpc.Server
@@ -35,33 +56,71 @@ type App struct {
 }
 
 // NewApp инициализирует все зависимости и возвращает готовое приложение
-func NewApp(db *database.Database) *App {
+func NewApp(db *database.Database, cfg *config.Config) (*App, error) {
 	userRepo := postgres.NewUserRepository(db.Pool)
 	habitRepo := postgres.NewHabitRepository(db.Pool)
 	habitLogRepo := postgres.NewHabitLogRepository(db.Pool)
 	habitReminderRepo := postgres.NewHabitReminderRepository(db.Pool)
 	streakResetQueueRepo := postgres.NewStreakResetQueueRepository(db.Pool)
+	schedulerRunRepo := postgres.NewSchedulerRunRepository(db.Pool)
+	jobRunRepo := postgres.NewJobRunRepository(db.Pool)
+	pauseRepo := postgres.NewPauseRepository(db.Pool)
+	streakFreezeRepo := postgres.NewStreakFreezeRepository(db.Pool)
+	relapseRepo := postgres.NewRelapseRepository(db.Pool)
+	timerRepo := postgres.NewTimerRepository(db.Pool)
+	skipRepo := postgres.NewSkipRepository(db.Pool)
+	scheduleRevisionRepo := postgres.NewScheduleRevisionRepository(db.Pool)
+	habitEventRepo := postgres.NewHabitEventRepository(db.Pool)
 
 	userService := service.NewUserService(userRepo)
-	habitService := service.NewHabitService(habitRepo, habitLogRepo, habitReminderRepo)
-	logService := service.NewLogService(habitLogRepo, habitRepo, habitReminderRepo, streakResetQueueRepo, habitService)
-	reminderService := service.NewReminderService(habitReminderRepo, habitRepo, habitService)
-	streakResetService := service.NewStreakResetService(streakResetQueueRepo, habitRepo, habitLogRepo, habitReminderRepo, habitService)
+	streakFreezeService := service.NewStreakFreezeService(streakFreezeRepo, streakResetQueueRepo, cfg.Freeze)
+	habitService := service.NewHabitService(userRepo, habitRepo, habitLogRepo, habitReminderRepo, pauseRepo, relapseRepo, skipRepo, scheduleRevisionRepo, streakFreezeService)
+	logService := service.NewLogService(habitLogRepo, habitRepo, habitReminderRepo, streakResetQueueRepo, skipRepo, habitService, cfg.Backfill)
+	reminderService := service.NewReminderService(habitReminderRepo, habitRepo, habitLogRepo, habitService)
+	streakResetService := service.NewStreakResetService(streakResetQueueRepo, userRepo, habitRepo, habitLogRepo, habitReminderRepo, habitService, streakFreezeService, cfg.StreakQueue)
+	pauseService := service.NewPauseService(pauseRepo, habitRepo, habitService, cfg.Backfill)
+	relapseService := service.NewRelapseService(relapseRepo, habitRepo, habitService, cfg.Backfill)
+	timerService := service.NewTimerService(timerRepo, habitRepo, userRepo, habitLogRepo, logService, cfg.Timer)
 
-	grpcServer := grpc.NewServer(
-		50051,
-		userService,
+	var publisher service.EventPublisher
+	if cfg.Events.WebhookURL != "" {
+		publisher = events.NewWebhookPublisher(cfg.Events.WebhookURL, cfg.Events.Timeout)
+	}
+	eventService := service.NewEventService(habitEventRepo, publisher, cfg.Events)
+
+	var leader scheduler.LeaderElector
+	if cfg.Scheduler.LeaderElection {
+		leader = database.NewLeaderElector(db, schedulerLockName, cfg.Scheduler.LeaderCheckInterval)
+	}
+
+	sched, err := scheduler.NewScheduler(
+		cfg.Scheduler,
+		leader,
+		schedulerRunRepo,
+		jobRunRepo,
 		habitService,
 		logService,
 		reminderService,
+		streakResetService,
+		userService,
+		eventService,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scheduler: %w", err)
+	}
 
-	sched := scheduler.NewScheduler(
+	grpcServer := grpc.NewServer(
+		50051,
+		userService,
 		habitService,
 		logService,
 		reminderService,
 		streakResetService,
-		userService,
+		pauseService,
+		streakFreezeService,
+		relapseService,
+		timerService,
+		sched,
 	)
 
 	return &App{
@@ -71,14 +130,28 @@ func NewApp(db *database.Database) *App {
 		HabitLogRepository:         habitLogRepo,
 		HabitReminderRepository:    habitReminderRepo,
 		StreakResetQueueRepository: streakResetQueueRepo,
+		SchedulerRunRepository:     schedulerRunRepo,
+		JobRunRepository:           jobRunRepo,
+		PauseRepository:            pauseRepo,
+		StreakFreezeRepository:     streakFreezeRepo,
+		RelapseRepository:          relapseRepo,
+		TimerRepository:            timerRepo,
+		SkipRepository:             skipRepo,
+		ScheduleRevisionRepository: scheduleRevisionRepo,
+		HabitEventRepository:       habitEventRepo,
 		UserService:                userService,
 		HabitService:               habitService,
 		LogService:                 logService,
 		ReminderService:            reminderService,
 		StreakResetService:         streakResetService,
+		PauseService:               pauseService,
+		StreakFreezeService:        streakFreezeService,
+		RelapseService:             relapseService,
+		TimerService:               timerService,
+		EventService:               eventService,
 		GRPCServer:                 grpcServer,
 		Scheduler:                  sched,
-	}
+	}, nil
 }
 
 // Close закрывает все подключения и останавливает сервисы
@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "HobitsService/gen/go/HobitsService/gen/go/hobbits/api/v1"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/infrastructure/scheduler"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/service"
+)
+
+// maxDeadLetterLimit максимальное число записей dead letter в ответе
+const maxDeadLetterLimit = 100
+
+// AdminServiceServer реализация AdminService
+type AdminServiceServer struct {
+	api.UnimplementedAdminServiceServer
+	scheduler          *scheduler.Scheduler
+	streakResetService *service.StreakResetService
+	freezeService      *service.StreakFreezeService
+}
+
+// NewAdminServiceServer создает новый AdminServiceServer
+func NewAdminServiceServer(
+	scheduler *scheduler.Scheduler,
+	streakResetService *service.StreakResetService,
+	freezeService *service.StreakFreezeService,
+) *AdminServiceServer {
+	return &AdminServiceServer{
+		scheduler:          scheduler,
+		streakResetService: streakResetService,
+		freezeService:      freezeService,
+	}
+}
+
+// ListJobRuns получает последние запуски задач
+func (s *AdminServiceServer) ListJobRuns(ctx context.Context, req *api.ListJobRunsRequest) (*api.ListJobRunsResponse, error) {
+	logger.Debug("ListJobRuns called", zap.String("job_name", req.JobName))
+
+	runs, err := s.scheduler.ListRuns(ctx, req.JobName, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, scheduler.ErrUnknownJob) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		logger.Error("failed to list job runs", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list job runs: %v", err)
+	}
+
+	protoRuns := make([]*api.JobRun, len(runs))
+	for i, run := range runs {
+		protoRuns[i] = jobRunToProto(run)
+	}
+
+	return &api.ListJobRunsResponse{
+		Runs: protoRuns,
+	}, nil
+}
+
+// GetJobRun получает запуск задачи по ID
+func (s *AdminServiceServer) GetJobRun(ctx context.Context, req *api.GetJobRunRequest) (*api.GetJobRunResponse, error) {
+	logger.Debug("GetJobRun called", zap.Int32("id", req.Id))
+
+	run, err := s.scheduler.GetRun(ctx, int(req.Id))
+	if err != nil {
+		logger.Error("failed to get job run", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "job run not found: %v", err)
+	}
+
+	return &api.GetJobRunResponse{
+		Run: jobRunToProto(run),
+	}, nil
+}
+
+// TriggerJob запускает задачу вне расписания
+func (s *AdminServiceServer) TriggerJob(ctx context.Context, req *api.TriggerJobRequest) (*api.TriggerJobResponse, error) {
+	logger.Info("TriggerJob called", zap.String("job_name", req.JobName))
+
+	run, err := s.scheduler.TriggerJob(ctx, req.JobName)
+	if err != nil {
+		switch {
+		case errors.Is(err, scheduler.ErrUnknownJob):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, scheduler.ErrJobAlreadyRunning):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, scheduler.ErrSchedulerStopped), errors.Is(err, scheduler.ErrNotLeader):
+			return nil, status.Errorf(codes.Unavailable, "%v", err)
+		}
+		logger.Error("failed to trigger job", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to trigger job: %v", err)
+	}
+
+	return &api.TriggerJobResponse{
+		Run: jobRunToProto(run),
+	}, nil
+}
+
+// ListDeadLetterEntries получает записи очереди сброса стриков, исчерпавшие попытки обработки
+func (s *AdminServiceServer) ListDeadLetterEntries(ctx context.Context, req *api.ListDeadLetterEntriesRequest) (*api.ListDeadLetterEntriesResponse, error) {
+	logger.Debug("ListDeadLetterEntries called", zap.Int32("limit", req.Limit))
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxDeadLetterLimit {
+		limit = maxDeadLetterLimit
+	}
+
+	entries, err := s.streakResetService.GetDeadLetterQueueEntries(ctx, limit)
+	if err != nil {
+		logger.Error("failed to list dead letter entries", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list dead letter entries: %v", err)
+	}
+
+	protoEntries := make([]*api.StreakResetQueueEntry, len(entries))
+	for i, entry := range entries {
+		protoEntries[i] = streakResetQueueEntryToProto(entry)
+	}
+
+	return &api.ListDeadLetterEntriesResponse{
+		Entries: protoEntries,
+	}, nil
+}
+
+// RequeueDeadLetterEntry возвращает запись из dead letter в очередь
+func (s *AdminServiceServer) RequeueDeadLetterEntry(ctx context.Context, req *api.RequeueDeadLetterEntryRequest) (*api.RequeueDeadLetterEntryResponse, error) {
+	logger.Info("RequeueDeadLetterEntry called", zap.Int32("id", req.Id))
+
+	entry, err := s.streakResetService.RequeueDeadLetterEntry(ctx, int(req.Id))
+	if err != nil {
+		if errors.Is(err, service.ErrQueueEntryNotDeadLettered) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		logger.Error("failed to requeue dead letter entry", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "queue entry not found: %v", err)
+	}
+
+	return &api.RequeueDeadLetterEntryResponse{
+		Entry: streakResetQueueEntryToProto(entry),
+	}, nil
+}
+
+// GrantFreezeTokens выдает пользователю заморозки
+func (s *AdminServiceServer) GrantFreezeTokens(ctx context.Context, req *api.GrantFreezeTokensRequest) (*api.GrantFreezeTokensResponse, error) {
+	logger.Info("GrantFreezeTokens called", zap.Int32("user_id", req.UserId), zap.Int32("amount", req.Amount))
+
+	balance, err := s.freezeService.GrantTokens(ctx, int(req.UserId), int(req.Amount))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidFreezeAmount) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		logger.Error("failed to grant freeze tokens", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to grant freeze tokens: %v", err)
+	}
+
+	return &api.GrantFreezeTokensResponse{
+		Tokens: int32(balance.Tokens),
+	}, nil
+}
@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"time"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "HobitsService/gen/go/HobitsService/gen/go/hobbits/api/v1"
@@ -44,17 +46,53 @@ func habitToProto(h *domain.Habit) *api.Habit {
 	if h.CompletedAt.Valid {
 		habit.CompletedAt = timestamppb.New(h.CompletedAt.Time)
 	}
+	if h.IntervalDays.Valid {
+		habit.IntervalDays = h.IntervalDays.Int32
+	}
+	if h.AnchorDate.Valid {
+		habit.AnchorDate = timestamppb.New(h.AnchorDate.Time)
+	}
+	if h.QuotaTarget.Valid {
+		habit.QuotaTarget = h.QuotaTarget.Int32
+	}
+	if h.QuotaPeriod.Valid {
+		habit.QuotaPeriod = h.QuotaPeriod.String
+	}
+	if h.RRule.Valid {
+		habit.Rrule = h.RRule.String
+		habit.RruleStart = timestamppb.New(h.RRuleStart.Time)
+	}
+	if h.TargetValue.Valid {
+		habit.TargetValue = h.TargetValue.Float64
+		habit.Unit = h.Unit.String
+	}
+	habit.DailyCount = int32(h.GetDailyCount())
+	if h.GraduationStreak.Valid {
+		habit.GraduationStreak = h.GraduationStreak.Int32
+	}
+	if h.GraduationRate.Valid {
+		habit.GraduationRate = h.GraduationRate.Int32
+		habit.GraduationWeeks = h.GraduationWeeks.Int32
+	}
+	if h.StartsOn.Valid {
+		habit.StartsOn = timestamppb.New(h.StartsOn.Time)
+	}
+	if h.EndsOn.Valid {
+		habit.EndsOn = timestamppb.New(h.EndsOn.Time)
+	}
 
 	return habit
 }
 
 func habitLogToProto(log *domain.HabitLog) *api.HabitLog {
 	return &api.HabitLog{
-		Id:         int32(log.ID),
-		HabitId:    int32(log.HabitID),
-		UserId:     int32(log.UserID),
-		LoggedAt:   timestamppb.New(log.LoggedAt),
-		LoggedDate: log.LoggedDate.Format("2006-01-02"),
+		Id:               int32(log.ID),
+		HabitId:          int32(log.HabitID),
+		UserId:           int32(log.UserID),
+		LoggedAt:         timestamppb.New(log.LoggedAt),
+		LoggedDate:       log.LoggedDate.Format("2006-01-02"),
+		Value:            log.GetValue(),
+		CompletionNumber: int32(log.CompletionNumber),
 	}
 }
 
@@ -65,6 +103,7 @@ func habitReminderToProto(r *domain.HabitReminder) *api.HabitReminder {
 		UserId:      int32(r.UserID),
 		IsCompleted: r.IsCompleted,
 		SentAt:      timestamppb.New(r.SentAt),
+		IsSkipped:   r.IsSkipped,
 	}
 
 	if r.ReminderDate.Valid {
@@ -73,3 +112,142 @@ func habitReminderToProto(r *domain.HabitReminder) *api.HabitReminder {
 
 	return reminder
 }
+
+func jobRunToProto(r *domain.JobRun) *api.JobRun {
+	run := &api.JobRun{
+		Id:              int32(r.ID),
+		JobName:         r.JobName,
+		Trigger:         string(r.Trigger),
+		Status:          string(r.Status),
+		StartedAt:       timestamppb.New(r.StartedAt),
+		DurationMs:      r.Duration().Milliseconds(),
+		UsersProcessed:  int32(r.UsersProcessed),
+		HabitsProcessed: int32(r.HabitsProcessed),
+		FailedCount:     int32(r.FailedCount),
+	}
+
+	if r.FinishedAt.Valid {
+		run.FinishedAt = timestamppb.New(r.FinishedAt.Time)
+	}
+	if r.Error.Valid {
+		run.Error = r.Error.String
+	}
+
+	return run
+}
+
+func streakResetQueueEntryToProto(e *domain.StreakResetQueue) *api.StreakResetQueueEntry {
+	entry := &api.StreakResetQueueEntry{
+		Id:            int32(e.ID),
+		HabitId:       int32(e.HabitID),
+		UserId:        int32(e.UserID),
+		Processed:     e.Processed,
+		Attempts:      int32(e.Attempts),
+		NextAttemptAt: timestamppb.New(e.NextAttemptAt),
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+	}
+
+	if e.ResetDate.Valid {
+		entry.ResetDate = timestamppb.New(e.ResetDate.Time)
+	}
+	if e.LastError.Valid {
+		entry.LastError = e.LastError.String
+	}
+	if e.DeadLetteredAt.Valid {
+		entry.DeadLetteredAt = timestamppb.New(e.DeadLetteredAt.Time)
+	}
+	if e.FrozenAt.Valid {
+		entry.FrozenAt = timestamppb.New(e.FrozenAt.Time)
+	}
+
+	return entry
+}
+
+func pauseToProto(p *domain.Pause) *api.Pause {
+	pause := &api.Pause{
+		Id:        int32(p.ID),
+		UserId:    int32(p.UserID),
+		StartDate: timestamppb.New(p.StartDate),
+		EndDate:   timestamppb.New(p.EndDate),
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+
+	if p.HabitID.Valid {
+		pause.HabitId = p.HabitID.Int32
+	}
+	if p.Reason.Valid {
+		pause.Reason = p.Reason.String
+	}
+	if p.CancelledAt.Valid {
+		pause.CancelledAt = timestamppb.New(p.CancelledAt.Time)
+	}
+
+	return pause
+}
+
+func relapseToProto(r *domain.Relapse) *api.Relapse {
+	return &api.Relapse{
+		Id:          int32(r.ID),
+		HabitId:     int32(r.HabitID),
+		UserId:      int32(r.UserID),
+		RelapseDate: r.RelapseDate.Format("2006-01-02"),
+		Note:        r.GetNote(),
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+	}
+}
+
+func skipToProto(s *domain.Skip) *api.Skip {
+	return &api.Skip{
+		Id:        int32(s.ID),
+		HabitId:   int32(s.HabitID),
+		UserId:    int32(s.UserID),
+		SkipDate:  s.SkipDate.Format("2006-01-02"),
+		Reason:    s.GetReason(),
+		CreatedAt: timestamppb.New(s.CreatedAt),
+	}
+}
+
+func timerSessionToProto(t *domain.TimerSession) *api.TimerSession {
+	timer := &api.TimerSession{
+		Id:             int32(t.ID),
+		HabitId:        int32(t.HabitID),
+		UserId:         int32(t.UserID),
+		StartedAt:      timestamppb.New(t.StartedAt),
+		ElapsedSeconds: int64(t.Elapsed(time.Now()).Seconds()),
+	}
+
+	if t.StoppedAt.Valid {
+		timer.StoppedAt = timestamppb.New(t.StoppedAt.Time)
+	}
+
+	return timer
+}
+
+func frozenDayToProto(e *domain.StreakResetQueue) *api.FrozenDay {
+	day := &api.FrozenDay{
+		HabitId: int32(e.HabitID),
+	}
+
+	if e.ResetDate.Valid {
+		day.Date = timestamppb.New(e.ResetDate.Time)
+	}
+	if e.FrozenAt.Valid {
+		day.FrozenAt = timestamppb.New(e.FrozenAt.Time)
+	}
+
+	return day
+}
+
+// challengeReportToProto конвертирует отчет челленджа в proto
+func challengeReportToProto(r *domain.ChallengeReport) *api.GetChallengeReportResponse {
+	return &api.GetChallengeReportResponse{
+		HabitId:        int32(r.HabitID),
+		StartsOn:       timestamppb.New(r.StartsOn),
+		EndsOn:         timestamppb.New(r.EndsOn),
+		Finished:       r.Finished,
+		DaysDone:       int32(r.Done),
+		DaysMissed:     int32(r.Missed),
+		DaysSkipped:    int32(r.Skipped),
+		CompletionRate: r.Rate,
+	}
+}
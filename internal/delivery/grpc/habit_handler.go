@@ -2,8 +2,10 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -33,6 +35,67 @@ func NewHabitServiceServer(habitService *service.HabitService) *HabitServiceServ
 func (s *HabitServiceServer) CreateHabit(ctx context.Context, req *api.CreateHabitRequest) (*api.CreateHabitResponse, error) {
 	logger.Debug("CreateHabit called", zap.Int32("user_id", req.UserId), zap.String("name", req.Name))
 
+	var anchorDate time.Time
+	if req.Frequency == string(domain.FrequencyInterval) {
+		if req.IntervalDays < 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", domain.ErrInvalidInterval)
+		}
+		if req.AnchorDate != "" {
+			var err error
+			anchorDate, err = time.Parse("2006-01-02", req.AnchorDate)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid anchor_date: %v", err)
+			}
+		}
+	}
+	var monthlyRules []string
+	if req.Frequency == string(domain.FrequencyMonthly) && req.MonthlyDays != "" {
+		parsed, err := domain.ParseMonthlyDays(req.MonthlyDays)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		for _, rule := range parsed {
+			monthlyRules = append(monthlyRules, rule.String())
+		}
+	}
+	if req.Frequency == string(domain.FrequencyQuota) {
+		if err := domain.ValidateQuota(int(req.QuotaTarget), domain.QuotaPeriod(req.QuotaPeriod)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+
+	var rruleStart time.Time
+	if req.Rrule != "" {
+		if _, err := domain.ParseRRule(req.Rrule); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if req.Frequency == string(domain.FrequencyQuota) || req.Frequency == string(domain.FrequencyQuit) {
+			return nil, status.Errorf(codes.InvalidArgument, "rrule is not supported for %s habits", req.Frequency)
+		}
+		if req.RruleStart != "" {
+			var err error
+			rruleStart, err = time.Parse("2006-01-02", req.RruleStart)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid rrule_start: %v", err)
+			}
+		}
+	}
+
+	if req.TargetValue < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", domain.ErrInvalidTarget)
+	}
+	if req.DailyCount < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", domain.ErrInvalidDailyCount)
+	}
+	if req.DailyCount > 1 && req.TargetValue > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "daily_count is not supported for habits with target_value")
+	}
+
+	startsOn, endsOn, err := parseChallengeDates(req.StartsOn, req.EndsOn)
+	if err != nil {
+		return nil, err
+	}
+
 	habit, err := s.habitService.CreateHabit(ctx, int(req.UserId), req.Name, domain.HabitFrequency(req.Frequency))
 	if err != nil {
 		logger.Error("failed to create habit", zap.Error(err))
@@ -46,8 +109,55 @@ func (s *HabitServiceServer) CreateHabit(ctx context.Context, req *api.CreateHab
 	}
 
 	if req.MonthlyDays != "" && req.Frequency == "monthly" {
-		days := parseIntDays(req.MonthlyDays)
-		habit, _ = s.habitService.SetMonthlyDays(ctx, habit.ID, days)
+		habit, _ = s.habitService.SetMonthlyDays(ctx, habit.ID, monthlyRules)
+	}
+
+	if req.Frequency == "interval" {
+		habit, err = s.habitService.SetInterval(ctx, habit.ID, int(req.IntervalDays), anchorDate)
+		if err != nil {
+			logger.Error("failed to set habit interval", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to set interval: %v", err)
+		}
+	}
+
+	if req.Frequency == "quota" {
+		habit, err = s.habitService.SetQuota(ctx, habit.ID, int(req.QuotaTarget), domain.QuotaPeriod(req.QuotaPeriod))
+		if err != nil {
+			logger.Error("failed to set habit quota", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to set quota: %v", err)
+		}
+	}
+
+	if req.Rrule != "" {
+		habit, err = s.habitService.SetRRule(ctx, habit.ID, req.Rrule, rruleStart)
+		if err != nil {
+			logger.Error("failed to set habit rrule", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to set rrule: %v", err)
+		}
+	}
+
+	if req.TargetValue > 0 {
+		habit, err = s.habitService.SetTarget(ctx, habit.ID, req.TargetValue, req.Unit)
+		if err != nil {
+			logger.Error("failed to set habit target", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to set target: %v", err)
+		}
+	}
+
+	if req.DailyCount > 1 {
+		habit, err = s.habitService.SetDailyCount(ctx, habit.ID, int(req.DailyCount))
+		if err != nil {
+			logger.Error("failed to set habit daily count", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to set daily count: %v", err)
+		}
+	}
+
+	if !startsOn.IsZero() || !endsOn.IsZero() {
+		habit, err = s.habitService.SetChallenge(ctx, habit.ID, startsOn, endsOn)
+		if err != nil {
+			logger.Error("failed to set habit challenge", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to set challenge: %v", err)
+		}
 	}
 
 	// Устанавливаем описание и цель
@@ -191,13 +301,20 @@ func (s *HabitServiceServer) SetWeeklyDays(ctx context.Context, req *api.SetWeek
 func (s *HabitServiceServer) SetMonthlyDays(ctx context.Context, req *api.SetMonthlyDaysRequest) (*api.SetMonthlyDaysResponse, error) {
 	logger.Debug("SetMonthlyDays called", zap.Int32("habit_id", req.HabitId))
 
-	days := make([]int, len(req.Days))
-	for i, d := range req.Days {
-		days[i] = int(d)
+	rules := make([]string, 0, len(req.Days)+len(req.Weekdays)+1)
+	for _, d := range req.Days {
+		rules = append(rules, strconv.Itoa(int(d)))
+	}
+	if req.LastDay {
+		rules = append(rules, "last")
 	}
+	rules = append(rules, req.Weekdays...)
 
-	habit, err := s.habitService.SetMonthlyDays(ctx, int(req.HabitId), days)
+	habit, err := s.habitService.SetMonthlyDays(ctx, int(req.HabitId), rules)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidMonthlyRule) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		logger.Error("failed to set monthly days", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to set monthly days: %v", err)
 	}
@@ -207,6 +324,69 @@ func (s *HabitServiceServer) SetMonthlyDays(ctx context.Context, req *api.SetMon
 	}, nil
 }
 
+// SetRecurrenceRule задает правило повторения RRULE
+func (s *HabitServiceServer) SetRecurrenceRule(ctx context.Context, req *api.SetRecurrenceRuleRequest) (*api.SetRecurrenceRuleResponse, error) {
+	logger.Debug("SetRecurrenceRule called", zap.Int32("habit_id", req.HabitId), zap.String("rrule", req.Rrule))
+
+	var start time.Time
+	if req.StartDate != "" {
+		var err error
+		start, err = time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start_date: %v", err)
+		}
+	}
+
+	habit, err := s.habitService.SetRRule(ctx, int(req.HabitId), req.Rrule, start)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidRRule) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		logger.Error("failed to set rrule", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set rrule: %v", err)
+	}
+
+	return &api.SetRecurrenceRuleResponse{
+		Habit: habitToProto(habit),
+	}, nil
+}
+
+// SetTarget задает дневную цель количественной привычки
+func (s *HabitServiceServer) SetTarget(ctx context.Context, req *api.SetTargetRequest) (*api.SetTargetResponse, error) {
+	logger.Debug("SetTarget called", zap.Int32("habit_id", req.HabitId), zap.Float64("target_value", req.TargetValue))
+
+	habit, err := s.habitService.SetTarget(ctx, int(req.HabitId), req.TargetValue, req.Unit)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidTarget) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		logger.Error("failed to set target", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set target: %v", err)
+	}
+
+	return &api.SetTargetResponse{
+		Habit: habitToProto(habit),
+	}, nil
+}
+
+// SetDailyCount задает, сколько раз за день нужно выполнить привычку
+func (s *HabitServiceServer) SetDailyCount(ctx context.Context, req *api.SetDailyCountRequest) (*api.SetDailyCountResponse, error) {
+	logger.Debug("SetDailyCount called", zap.Int32("habit_id", req.HabitId), zap.Int32("daily_count", req.DailyCount))
+
+	habit, err := s.habitService.SetDailyCount(ctx, int(req.HabitId), int(req.DailyCount))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidDailyCount) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		logger.Error("failed to set daily count", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set daily count: %v", err)
+	}
+
+	return &api.SetDailyCountResponse{
+		Habit: habitToProto(habit),
+	}, nil
+}
+
 // IsScheduledToday проверяет, нужно ли подтверждение сегодня
 func (s *HabitServiceServer) IsScheduledToday(ctx context.Context, req *api.IsScheduledTodayRequest) (*api.IsScheduledTodayResponse, error) {
 	logger.Debug("IsScheduledToday called", zap.Int32("habit_id", req.HabitId))
@@ -222,6 +402,136 @@ func (s *HabitServiceServer) IsScheduledToday(ctx context.Context, req *api.IsSc
 	}, nil
 }
 
+// RecomputeStreaks пересчитывает стрики по истории логов
+func (s *HabitServiceServer) RecomputeStreaks(ctx context.Context, req *api.RecomputeStreaksRequest) (*api.RecomputeStreaksResponse, error) {
+	logger.Info("RecomputeStreaks called", zap.Int32("habit_id", req.HabitId), zap.Int32("user_id", req.UserId))
+
+	var habits []*domain.Habit
+	switch {
+	case req.HabitId != 0:
+		habit, err := s.habitService.RecomputeStreak(ctx, int(req.HabitId))
+		if err != nil {
+			logger.Error("failed to recompute streak", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to recompute streak: %v", err)
+		}
+		habits = []*domain.Habit{habit}
+
+	case req.UserId != 0:
+		var err error
+		habits, err = s.habitService.RecomputeUserStreaks(ctx, int(req.UserId))
+		if err != nil {
+			logger.Error("failed to recompute user streaks", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to recompute streaks: %v", err)
+		}
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "habit_id or user_id is required")
+	}
+
+	protoHabits := make([]*api.Habit, len(habits))
+	for i, h := range habits {
+		protoHabits[i] = habitToProto(h)
+	}
+
+	return &api.RecomputeStreaksResponse{
+		Habits: protoHabits,
+	}, nil
+}
+
+// SetGraduation задает критерии, по которым привычка считается выработанной
+func (s *HabitServiceServer) SetGraduation(ctx context.Context, req *api.SetGraduationRequest) (*api.SetGraduationResponse, error) {
+	logger.Debug("SetGraduation called", zap.Int32("habit_id", req.HabitId), zap.Int32("streak_days", req.StreakDays), zap.Int32("completion_rate", req.CompletionRate))
+
+	habit, err := s.habitService.SetGraduation(ctx, int(req.HabitId), int(req.StreakDays), int(req.CompletionRate), int(req.Weeks))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidGraduation) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		logger.Error("failed to set graduation", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set graduation: %v", err)
+	}
+
+	return &api.SetGraduationResponse{
+		Habit: habitToProto(habit),
+	}, nil
+}
+
+// GetFormedHabits получает выработанные привычки пользователя
+func (s *HabitServiceServer) GetFormedHabits(ctx context.Context, req *api.GetFormedHabitsRequest) (*api.GetFormedHabitsResponse, error) {
+	logger.Debug("GetFormedHabits called", zap.Int32("user_id", req.UserId))
+
+	habits, err := s.habitService.GetFormedHabits(ctx, int(req.UserId))
+	if err != nil {
+		logger.Error("failed to get formed habits", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get formed habits: %v", err)
+	}
+
+	protoHabits := make([]*api.Habit, len(habits))
+	for i, h := range habits {
+		protoHabits[i] = habitToProto(h)
+	}
+
+	return &api.GetFormedHabitsResponse{
+		Habits: protoHabits,
+	}, nil
+}
+
+// SetChallenge ограничивает привычку датами начала и окончания
+func (s *HabitServiceServer) SetChallenge(ctx context.Context, req *api.SetChallengeRequest) (*api.SetChallengeResponse, error) {
+	logger.Debug("SetChallenge called", zap.Int32("habit_id", req.HabitId), zap.String("starts_on", req.StartsOn), zap.String("ends_on", req.EndsOn))
+
+	startsOn, endsOn, err := parseChallengeDates(req.StartsOn, req.EndsOn)
+	if err != nil {
+		return nil, err
+	}
+
+	habit, err := s.habitService.SetChallenge(ctx, int(req.HabitId), startsOn, endsOn)
+	if err != nil {
+		logger.Error("failed to set challenge", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set challenge: %v", err)
+	}
+
+	return &api.SetChallengeResponse{
+		Habit: habitToProto(habit),
+	}, nil
+}
+
+// GetChallengeReport получает отчет челленджа
+func (s *HabitServiceServer) GetChallengeReport(ctx context.Context, req *api.GetChallengeReportRequest) (*api.GetChallengeReportResponse, error) {
+	logger.Debug("GetChallengeReport called", zap.Int32("habit_id", req.HabitId))
+
+	report, err := s.habitService.GetChallengeReport(ctx, int(req.HabitId))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotChallenge) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		logger.Error("failed to get challenge report", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get challenge report: %v", err)
+	}
+
+	return challengeReportToProto(report), nil
+}
+
+// parseChallengeDates парсит даты начала и окончания челленджа; пустая строка - без границы
+func parseChallengeDates(startsOn, endsOn string) (start, end time.Time, err error) {
+	if startsOn != "" {
+		start, err = time.Parse("2006-01-02", startsOn)
+		if err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid starts_on: %v", err)
+		}
+	}
+	if endsOn != "" {
+		end, err = time.Parse("2006-01-02", endsOn)
+		if err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid ends_on: %v", err)
+		}
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "%v", domain.ErrInvalidChallenge)
+	}
+	return start, end, nil
+}
+
 // parseIntDays парсит строку "1,3,5" в []int
 func parseIntDays(daysStr string) []int {
 	parts := strings.Split(daysStr, ",")
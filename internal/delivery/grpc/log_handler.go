@@ -2,13 +2,16 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	api "HobitsService/gen/go/HobitsService/gen/go/hobbits/api/v1"
+	"HobitsService/internal/domain"
 	"HobitsService/internal/logger"
 	"HobitsService/internal/metrics"
 	"HobitsService/internal/service"
@@ -31,8 +34,28 @@ func NewLogServiceServer(logService *service.LogService) *LogServiceServer {
 func (s *LogServiceServer) LogCompletion(ctx context.Context, req *api.LogCompletionRequest) (*api.LogCompletionResponse, error) {
 	logger.Debug("LogCompletion called", zap.Int32("habit_id", req.HabitId), zap.Int32("user_id", req.UserId))
 
-	log, err := s.logService.LogCompletion(ctx, int(req.HabitId), int(req.UserId), req.Comment)
+	var loggedDate time.Time
+	if req.LoggedDate != "" {
+		var err error
+		loggedDate, err = time.Parse("2006-01-02", req.LoggedDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid logged_date: %v", err)
+		}
+	}
+
+	log, progress, err := s.logService.LogCompletion(ctx, int(req.HabitId), int(req.UserId), req.Comment, loggedDate, req.Value)
 	if err != nil {
+		if errors.Is(err, service.ErrFutureLogDate) ||
+			errors.Is(err, service.ErrLogDateOutsideBackfill) ||
+			errors.Is(err, service.ErrHabitNotScheduled) ||
+			errors.Is(err, domain.ErrInvalidLogValue) ||
+			errors.Is(err, domain.ErrOutsideChallenge) ||
+			errors.Is(err, service.ErrQuitHabitCompletion) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrUnauthorized) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
 		logger.Error("failed to log completion", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to log completion: %v", err)
 	}
@@ -46,6 +69,32 @@ func (s *LogServiceServer) LogCompletion(ctx context.Context, req *api.LogComple
 	return &api.LogCompletionResponse{
 		Log:               habitLogToProto(log),
 		IsFirstCompletion: log.ID > 0,
+		DayCompleted:      progress.Completed,
+		DayTotal:          progress.Total,
+		CompletionsToday:  int32(progress.Count),
+		DailyCount:        int32(progress.Target),
+	}, nil
+}
+
+// DeleteLog удаляет лог выполнения и откатывает стрик
+func (s *LogServiceServer) DeleteLog(ctx context.Context, req *api.DeleteLogRequest) (*api.DeleteLogResponse, error) {
+	logger.Debug("DeleteLog called", zap.Int32("log_id", req.LogId), zap.Int32("user_id", req.UserId))
+
+	habit, err := s.logService.DeleteLog(ctx, int(req.LogId), int(req.UserId))
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		if errors.Is(err, service.ErrLogNotFound) {
+			return nil, status.Errorf(codes.NotFound, "log not found")
+		}
+		logger.Error("failed to delete log", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete log: %v", err)
+	}
+
+	return &api.DeleteLogResponse{
+		Success: true,
+		Habit:   habitToProto(habit),
 	}, nil
 }
 
@@ -105,7 +154,61 @@ func (s *LogServiceServer) GetCompletionRate(ctx context.Context, req *api.GetCo
 		return nil, status.Errorf(codes.Internal, "failed to get completion rate: %v", err)
 	}
 
+	stats, err := s.logService.GetValueStats(ctx, int(req.HabitId), fromDate, toDate)
+	if err != nil {
+		logger.Error("failed to get value stats", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get completion rate: %v", err)
+	}
+
+	skips, err := s.logService.GetSkips(ctx, int(req.HabitId), fromDate, toDate)
+	if err != nil {
+		logger.Error("failed to get skips", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get completion rate: %v", err)
+	}
+
 	return &api.GetCompletionRateResponse{
-		Rate: float32(rate),
+		Rate:         float32(rate),
+		TotalValue:   stats.Total,
+		AverageValue: stats.DailyAverage,
+		Unit:         stats.Unit,
+		TargetValue:  stats.Target,
+		Skipped:      int32(len(skips)),
+	}, nil
+}
+
+// SkipDay отмечает запланированный день как пропущенный по уважительной причине
+func (s *LogServiceServer) SkipDay(ctx context.Context, req *api.SkipDayRequest) (*api.SkipDayResponse, error) {
+	logger.Debug("SkipDay called", zap.Int32("habit_id", req.HabitId), zap.Int32("user_id", req.UserId))
+
+	var date time.Time
+	if req.Date != "" {
+		var err error
+		date, err = time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date: %v", err)
+		}
+	}
+
+	skip, habit, err := s.logService.SkipDay(ctx, int(req.HabitId), int(req.UserId), date, req.Reason)
+	if err != nil {
+		if errors.Is(err, service.ErrFutureLogDate) ||
+			errors.Is(err, service.ErrLogDateOutsideBackfill) ||
+			errors.Is(err, service.ErrHabitNotScheduled) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, domain.ErrSkipNotSupported) ||
+			errors.Is(err, service.ErrDayAlreadyLogged) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, service.ErrUnauthorized) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		logger.Error("failed to skip day", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to skip day: %v", err)
+	}
+
+	return &api.SkipDayResponse{
+		Skip:  skipToProto(skip),
+		Habit: habitToProto(habit),
 	}, nil
 }
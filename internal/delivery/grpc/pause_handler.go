@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "HobitsService/gen/go/HobitsService/gen/go/hobbits/api/v1"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/service"
+)
+
+// PauseServiceServer реализация PauseService
+type PauseServiceServer struct {
+	api.UnimplementedPauseServiceServer
+	pauseService *service.PauseService
+}
+
+// NewPauseServiceServer создает новый PauseServiceServer
+func NewPauseServiceServer(pauseService *service.PauseService) *PauseServiceServer {
+	return &PauseServiceServer{
+		pauseService: pauseService,
+	}
+}
+
+// CreatePause ставит на паузу привычку или все привычки пользователя
+func (s *PauseServiceServer) CreatePause(ctx context.Context, req *api.CreatePauseRequest) (*api.CreatePauseResponse, error) {
+	logger.Debug("CreatePause called", zap.Int32("user_id", req.UserId), zap.Int32("habit_id", req.HabitId))
+
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_date: %v", err)
+	}
+	end, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end_date: %v", err)
+	}
+
+	pause, err := s.pauseService.CreatePause(ctx, int(req.UserId), int(req.HabitId), start, end, req.Reason)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPausePeriod) || errors.Is(err, service.ErrPauseStartOutsideBackfill) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrUnauthorized) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		logger.Error("failed to create pause", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create pause: %v", err)
+	}
+
+	return &api.CreatePauseResponse{
+		Pause: pauseToProto(pause),
+	}, nil
+}
+
+// ListPauses получает паузы пользователя
+func (s *PauseServiceServer) ListPauses(ctx context.Context, req *api.ListPausesRequest) (*api.ListPausesResponse, error) {
+	logger.Debug("ListPauses called", zap.Int32("user_id", req.UserId), zap.Int32("habit_id", req.HabitId))
+
+	pauses, err := s.pauseService.ListPauses(ctx, int(req.UserId), int(req.HabitId))
+	if err != nil {
+		logger.Error("failed to list pauses", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list pauses: %v", err)
+	}
+
+	protoPauses := make([]*api.Pause, len(pauses))
+	for i, p := range pauses {
+		protoPauses[i] = pauseToProto(p)
+	}
+
+	return &api.ListPausesResponse{
+		Pauses: protoPauses,
+	}, nil
+}
+
+// CancelPause отменяет паузу
+func (s *PauseServiceServer) CancelPause(ctx context.Context, req *api.CancelPauseRequest) (*api.CancelPauseResponse, error) {
+	logger.Debug("CancelPause called", zap.Int32("pause_id", req.PauseId), zap.Int32("user_id", req.UserId))
+
+	pause, err := s.pauseService.CancelPause(ctx, int(req.PauseId), int(req.UserId))
+	if err != nil {
+		if errors.Is(err, service.ErrPauseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "pause not found")
+		}
+		if errors.Is(err, service.ErrUnauthorized) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		if errors.Is(err, domain.ErrPauseAlreadyCancelled) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		logger.Error("failed to cancel pause", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to cancel pause: %v", err)
+	}
+
+	return &api.CancelPauseResponse{
+		Pause: pauseToProto(pause),
+	}, nil
+}
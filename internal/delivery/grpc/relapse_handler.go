@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "HobitsService/gen/go/HobitsService/gen/go/hobbits/api/v1"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/service"
+)
+
+// RelapseServiceServer реализация RelapseService
+type RelapseServiceServer struct {
+	api.UnimplementedRelapseServiceServer
+	relapseService *service.RelapseService
+}
+
+// NewRelapseServiceServer создает новый RelapseServiceServer
+func NewRelapseServiceServer(relapseService *service.RelapseService) *RelapseServiceServer {
+	return &RelapseServiceServer{
+		relapseService: relapseService,
+	}
+}
+
+// LogRelapse записывает срыв по привычке-отказу
+func (s *RelapseServiceServer) LogRelapse(ctx context.Context, req *api.LogRelapseRequest) (*api.LogRelapseResponse, error) {
+	logger.Debug("LogRelapse called", zap.Int32("habit_id", req.HabitId), zap.Int32("user_id", req.UserId))
+
+	var relapseDate time.Time
+	if req.RelapseDate != "" {
+		var err error
+		relapseDate, err = time.Parse("2006-01-02", req.RelapseDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid relapse_date: %v", err)
+		}
+	}
+
+	relapse, habit, err := s.relapseService.LogRelapse(ctx, int(req.HabitId), int(req.UserId), relapseDate, req.Note)
+	if err != nil {
+		if errors.Is(err, service.ErrFutureLogDate) || errors.Is(err, service.ErrLogDateOutsideBackfill) ||
+			errors.Is(err, domain.ErrOutsideChallenge) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, domain.ErrNotQuitHabit) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, service.ErrUnauthorized) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		logger.Error("failed to log relapse", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to log relapse: %v", err)
+	}
+
+	return &api.LogRelapseResponse{
+		Relapse: relapseToProto(relapse),
+		Habit:   habitToProto(habit),
+	}, nil
+}
+
+// ListRelapses получает срывы привычки
+func (s *RelapseServiceServer) ListRelapses(ctx context.Context, req *api.ListRelapsesRequest) (*api.ListRelapsesResponse, error) {
+	logger.Debug("ListRelapses called", zap.Int32("habit_id", req.HabitId))
+
+	relapses, err := s.relapseService.ListRelapses(ctx, int(req.HabitId))
+	if err != nil {
+		logger.Error("failed to list relapses", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list relapses: %v", err)
+	}
+
+	protoRelapses := make([]*api.Relapse, len(relapses))
+	for i, r := range relapses {
+		protoRelapses[i] = relapseToProto(r)
+	}
+
+	return &api.ListRelapsesResponse{
+		Relapses: protoRelapses,
+	}, nil
+}
+
+// GetRelapseStats получает самую длинную чистую серию и частоту срывов
+func (s *RelapseServiceServer) GetRelapseStats(ctx context.Context, req *api.GetRelapseStatsRequest) (*api.GetRelapseStatsResponse, error) {
+	logger.Debug("GetRelapseStats called", zap.Int32("habit_id", req.HabitId))
+
+	from, err := time.Parse("2006-01-02", req.FromDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from_date: %v", err)
+	}
+	to, err := time.Parse("2006-01-02", req.ToDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to_date: %v", err)
+	}
+
+	stats, err := s.relapseService.GetRelapseStats(ctx, int(req.HabitId), from, to, domain.QuotaPeriod(req.Period))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidStatsPeriod) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, domain.ErrNotQuitHabit) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		logger.Error("failed to get relapse stats", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get relapse stats: %v", err)
+	}
+
+	periods := make([]*api.RelapsePeriod, len(stats.Periods))
+	for i, p := range stats.Periods {
+		periods[i] = &api.RelapsePeriod{
+			StartDate: p.Start.Format("2006-01-02"),
+			Relapses:  int32(p.Count),
+		}
+	}
+
+	return &api.GetRelapseStatsResponse{
+		CurrentCleanRun: int32(stats.CurrentCleanRun),
+		LongestCleanRun: int32(stats.LongestCleanRun),
+		TotalRelapses:   int32(stats.TotalRelapses),
+		Periods:         periods,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 
 	api "HobitsService/gen/go/HobitsService/gen/go/hobbits/api/v1"
+	"HobitsService/internal/infrastructure/scheduler"
 	"HobitsService/internal/logger"
 	"HobitsService/internal/service"
 )
@@ -17,10 +18,16 @@ type Server struct {
 	server *grpc.Server
 	port   int
 
-	userService     *service.UserService
-	habitService    *service.HabitService
-	logService      *service.LogService
-	reminderService *service.ReminderService
+	userService        *service.UserService
+	habitService       *service.HabitService
+	logService         *service.LogService
+	reminderService    *service.ReminderService
+	streakResetService *service.StreakResetService
+	pauseService       *service.PauseService
+	freezeService      *service.StreakFreezeService
+	relapseService     *service.RelapseService
+	timerService       *service.TimerService
+	scheduler          *scheduler.Scheduler
 }
 
 // NewServer создает новый gRPC сервер
@@ -30,13 +37,25 @@ func NewServer(
 	habitService *service.HabitService,
 	logService *service.LogService,
 	reminderService *service.ReminderService,
+	streakResetService *service.StreakResetService,
+	pauseService *service.PauseService,
+	freezeService *service.StreakFreezeService,
+	relapseService *service.RelapseService,
+	timerService *service.TimerService,
+	scheduler *scheduler.Scheduler,
 ) *Server {
 	return &Server{
-		port:            port,
-		userService:     userService,
-		habitService:    habitService,
-		logService:      logService,
-		reminderService: reminderService,
+		port:               port,
+		userService:        userService,
+		habitService:       habitService,
+		logService:         logService,
+		reminderService:    reminderService,
+		streakResetService: streakResetService,
+		pauseService:       pauseService,
+		freezeService:      freezeService,
+		relapseService:     relapseService,
+		timerService:       timerService,
+		scheduler:          scheduler,
 	}
 }
 
@@ -48,6 +67,11 @@ func (s *Server) Start() error {
 	api.RegisterHabitServiceServer(s.server, NewHabitServiceServer(s.habitService))
 	api.RegisterLogServiceServer(s.server, NewLogServiceServer(s.logService))
 	api.RegisterReminderServiceServer(s.server, NewReminderServiceServer(s.reminderService))
+	api.RegisterAdminServiceServer(s.server, NewAdminServiceServer(s.scheduler, s.streakResetService, s.freezeService))
+	api.RegisterPauseServiceServer(s.server, NewPauseServiceServer(s.pauseService))
+	api.RegisterStreakFreezeServiceServer(s.server, NewStreakFreezeServiceServer(s.freezeService))
+	api.RegisterRelapseServiceServer(s.server, NewRelapseServiceServer(s.relapseService))
+	api.RegisterTimerServiceServer(s.server, NewTimerServiceServer(s.timerService))
 
 	addr := fmt.Sprintf(":%d", s.port)
 	listener, err := net.Listen("tcp", addr)
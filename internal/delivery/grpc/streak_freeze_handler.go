@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "HobitsService/gen/go/HobitsService/gen/go/hobbits/api/v1"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/service"
+)
+
+// StreakFreezeServiceServer реализация StreakFreezeService
+type StreakFreezeServiceServer struct {
+	api.UnimplementedStreakFreezeServiceServer
+	freezeService *service.StreakFreezeService
+}
+
+// NewStreakFreezeServiceServer создает новый StreakFreezeServiceServer
+func NewStreakFreezeServiceServer(freezeService *service.StreakFreezeService) *StreakFreezeServiceServer {
+	return &StreakFreezeServiceServer{
+		freezeService: freezeService,
+	}
+}
+
+// GetFreezeBalance получает число заморозок пользователя
+func (s *StreakFreezeServiceServer) GetFreezeBalance(ctx context.Context, req *api.GetFreezeBalanceRequest) (*api.GetFreezeBalanceResponse, error) {
+	logger.Debug("GetFreezeBalance called", zap.Int32("user_id", req.UserId))
+
+	balance, err := s.freezeService.GetBalance(ctx, int(req.UserId))
+	if err != nil {
+		logger.Error("failed to get freeze balance", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get freeze balance: %v", err)
+	}
+
+	return &api.GetFreezeBalanceResponse{
+		Tokens:          int32(balance.Tokens),
+		MaxEarnedTokens: int32(s.freezeService.MaxTokens()),
+	}, nil
+}
+
+// ListFrozenDays получает пропуски, закрытые заморозкой
+func (s *StreakFreezeServiceServer) ListFrozenDays(ctx context.Context, req *api.ListFrozenDaysRequest) (*api.ListFrozenDaysResponse, error) {
+	logger.Debug("ListFrozenDays called", zap.Int32("user_id", req.UserId), zap.Int32("habit_id", req.HabitId))
+
+	entries, err := s.freezeService.GetFrozenDays(ctx, int(req.UserId), int(req.HabitId), int(req.Limit))
+	if err != nil {
+		logger.Error("failed to list frozen days", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list frozen days: %v", err)
+	}
+
+	days := make([]*api.FrozenDay, len(entries))
+	for i, e := range entries {
+		days[i] = frozenDayToProto(e)
+	}
+
+	return &api.ListFrozenDaysResponse{
+		Days: days,
+	}, nil
+}
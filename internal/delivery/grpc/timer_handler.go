@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "HobitsService/gen/go/HobitsService/gen/go/hobbits/api/v1"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/service"
+)
+
+// TimerServiceServer реализация TimerService
+type TimerServiceServer struct {
+	api.UnimplementedTimerServiceServer
+	timerService *service.TimerService
+}
+
+// NewTimerServiceServer создает новый TimerServiceServer
+func NewTimerServiceServer(timerService *service.TimerService) *TimerServiceServer {
+	return &TimerServiceServer{
+		timerService: timerService,
+	}
+}
+
+// StartTimer запускает таймер привычки
+func (s *TimerServiceServer) StartTimer(ctx context.Context, req *api.StartTimerRequest) (*api.StartTimerResponse, error) {
+	logger.Debug("StartTimer called", zap.Int32("habit_id", req.HabitId), zap.Int32("user_id", req.UserId))
+
+	timer, err := s.timerService.StartTimer(ctx, int(req.HabitId), int(req.UserId))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotDurationHabit) || errors.Is(err, domain.ErrTimerAlreadyRunning) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		if errors.Is(err, service.ErrUnauthorized) {
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+		logger.Error("failed to start timer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to start timer: %v", err)
+	}
+
+	return &api.StartTimerResponse{
+		Timer: timerSessionToProto(timer),
+	}, nil
+}
+
+// StopTimer останавливает таймер пользователя и засчитывает время
+func (s *TimerServiceServer) StopTimer(ctx context.Context, req *api.StopTimerRequest) (*api.StopTimerResponse, error) {
+	logger.Debug("StopTimer called", zap.Int32("user_id", req.UserId))
+
+	timer, progress, err := s.timerService.StopTimer(ctx, int(req.UserId))
+	if err != nil {
+		if errors.Is(err, domain.ErrNoActiveTimer) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		logger.Error("failed to stop timer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to stop timer: %v", err)
+	}
+
+	return &api.StopTimerResponse{
+		Timer:        timerSessionToProto(timer),
+		DayMinutes:   progress.Total,
+		DayCompleted: progress.Completed,
+	}, nil
+}
+
+// GetActiveTimer получает запущенный таймер пользователя
+func (s *TimerServiceServer) GetActiveTimer(ctx context.Context, req *api.GetActiveTimerRequest) (*api.GetActiveTimerResponse, error) {
+	logger.Debug("GetActiveTimer called", zap.Int32("user_id", req.UserId))
+
+	timer, err := s.timerService.GetActiveTimer(ctx, int(req.UserId))
+	if err != nil {
+		logger.Error("failed to get active timer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get active timer: %v", err)
+	}
+	if timer == nil {
+		return &api.GetActiveTimerResponse{}, nil
+	}
+
+	return &api.GetActiveTimerResponse{
+		Timer:   timerSessionToProto(timer),
+		Running: true,
+	}, nil
+}
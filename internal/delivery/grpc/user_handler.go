@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -38,9 +39,13 @@ func (s *UserServiceServer) GetOrCreateUser(ctx context.Context, req *api.GetOrC
 		req.LastName,
 		req.Username,
 		req.LanguageCode,
+		req.Timezone,
 	)
 	if err != nil {
 		logger.Error("failed to get or create user", zap.Error(err))
+		if errors.Is(err, domain.ErrInvalidTimezone) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timezone: %s", req.Timezone)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get or create user: %v", err)
 	}
 
@@ -76,9 +81,13 @@ func (s *UserServiceServer) UpdateUser(ctx context.Context, req *api.UpdateUserR
 		req.LastName,
 		req.Username,
 		req.LanguageCode,
+		req.Timezone,
 	)
 	if err != nil {
 		logger.Error("failed to update user", zap.Error(err))
+		if errors.Is(err, domain.ErrInvalidTimezone) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timezone: %s", req.Timezone)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
 
@@ -87,16 +96,37 @@ func (s *UserServiceServer) UpdateUser(ctx context.Context, req *api.UpdateUserR
 	}, nil
 }
 
+// SetDayBoundary устанавливает час начала дня и окно льготы пользователя
+func (s *UserServiceServer) SetDayBoundary(ctx context.Context, req *api.SetDayBoundaryRequest) (*api.SetDayBoundaryResponse, error) {
+	logger.Debug("SetDayBoundary called", zap.Int32("id", req.Id))
+
+	user, err := s.userService.SetDayBoundary(ctx, int(req.Id), int(req.DayStartHour), int(req.LogGraceMinutes))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidDayBoundary) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		logger.Error("failed to set day boundary", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set day boundary: %v", err)
+	}
+
+	return &api.SetDayBoundaryResponse{
+		User: domainUserToProto(user),
+	}, nil
+}
+
 // domainUserToProto преобразует domain модель в proto сообщение
 func domainUserToProto(user *domain.User) *api.User {
 	return &api.User{
-		Id:           int32(user.ID),
-		TelegramId:   user.TelegramID,
-		FirstName:    user.FirstName,
-		LastName:     user.LastName,
-		Username:     user.Username,
-		LanguageCode: user.LanguageCode,
-		CreatedAt:    timestamppb.New(user.CreatedAt),
-		UpdatedAt:    timestamppb.New(user.UpdatedAt),
+		Id:              int32(user.ID),
+		TelegramId:      user.TelegramID,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Username:        user.Username,
+		LanguageCode:    user.LanguageCode,
+		Timezone:        user.Timezone,
+		DayStartHour:    int32(user.DayStartHour),
+		LogGraceMinutes: int32(user.LogGraceMinutes),
+		CreatedAt:       timestamppb.New(user.CreatedAt),
+		UpdatedAt:       timestamppb.New(user.UpdatedAt),
 	}
 }
@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrInvalidChallenge возвращается, если челлендж заканчивается раньше, чем начинается
+	ErrInvalidChallenge = errors.New("challenge must not end before it starts")
+	// ErrNotChallenge возвращается при запросе отчета по привычке без даты окончания
+	ErrNotChallenge = errors.New("habit is not a challenge")
+	// ErrOutsideChallenge возвращается при отметке дня вне окна челленджа
+	ErrOutsideChallenge = errors.New("date is outside the challenge window")
+)
+
+// SetChallenge ограничивает привычку окном [start, end]; нулевая дата снимает соответствующую границу
+func (h *Habit) SetChallenge(start, end time.Time) error {
+	if !start.IsZero() && !end.IsZero() && DateOf(end).Before(DateOf(start)) {
+		return ErrInvalidChallenge
+	}
+
+	h.StartsOn = sql.NullTime{Time: DateOf(start), Valid: !start.IsZero()}
+	h.EndsOn = sql.NullTime{Time: DateOf(end), Valid: !end.IsZero()}
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsChallenge проверяет, ограничена ли привычка датой окончания
+func (h *Habit) IsChallenge() bool {
+	return h.EndsOn.Valid
+}
+
+// IsWithinWindow проверяет, попадает ли дата в окно привычки; без окна подходит любая дата
+func (h *Habit) IsWithinWindow(date time.Time) bool {
+	date = DateOf(date)
+	if h.StartsOn.Valid && date.Before(DateOf(h.StartsOn.Time)) {
+		return false
+	}
+	return !h.EndsOn.Valid || !date.After(DateOf(h.EndsOn.Time))
+}
+
+// IsChallengeOverOn проверяет, закончился ли челлендж к дате date
+func (h *Habit) IsChallengeOverOn(date time.Time) bool {
+	return h.EndsOn.Valid && DateOf(date).After(DateOf(h.EndsOn.Time))
+}
+
+// clampToWindow сужает интервал [from, to] до окна привычки; если они не пересекаются, from окажется позже to
+func (h *Habit) clampToWindow(from, to time.Time) (time.Time, time.Time) {
+	if h.StartsOn.Valid && from.Before(DateOf(h.StartsOn.Time)) {
+		from = DateOf(h.StartsOn.Time)
+	}
+	if h.EndsOn.Valid && to.After(DateOf(h.EndsOn.Time)) {
+		to = DateOf(h.EndsOn.Time)
+	}
+	return from, to
+}
+
+// windowStart возвращает первый день истории привычки: начало окна или день создания
+func (h *Habit) windowStart() time.Time {
+	if h.StartsOn.Valid {
+		return DateOf(h.StartsOn.Time)
+	}
+	return DateOf(h.CreatedAt)
+}
+
+// ChallengeReport итог челленджа за его окно
+type ChallengeReport struct {
+	HabitID  int       `json:"habit_id"`
+	Name     string    `json:"name"`
+	StartsOn time.Time `json:"starts_on"`
+	EndsOn   time.Time `json:"ends_on"`
+	// Finished челлендж закончился; иначе отчет промежуточный, по сегодняшний день
+	Finished bool `json:"finished"`
+	// Done выполненные дни; у привычки-отказа - дни без срыва
+	Done int `json:"days_done"`
+	// Missed пропущенные дни; у квоты - периоды с невыполненной квотой, у отказа - дни срывов
+	Missed int `json:"days_missed"`
+	// Skipped дни с уважительным пропуском
+	Skipped int `json:"days_skipped"`
+	// Rate процент выполнения; уважительные пропуски и сегодняшний невыполненный день не учитываются
+	Rate float64 `json:"completion_rate"`
+}
+
+// ChallengeReport считает итог челленджа на дату today. completed - выполненные дни,
+// у привычки-отказа - дни срывов. Паузы, пропуски и редакции расписания должны быть загружены.
+func (h *Habit) ChallengeReport(completed []time.Time, today time.Time) (*ChallengeReport, error) {
+	if !h.IsChallenge() {
+		return nil, ErrNotChallenge
+	}
+
+	today = DateOf(today)
+	report := &ChallengeReport{
+		HabitID:  h.ID,
+		Name:     h.Name,
+		StartsOn: h.windowStart(),
+		EndsOn:   DateOf(h.EndsOn.Time),
+		Finished: h.IsChallengeOverOn(today),
+	}
+
+	to := report.EndsOn
+	if !report.Finished {
+		to = today
+	}
+	if to.Before(report.StartsOn) {
+		return report, nil
+	}
+
+	logged := make(map[time.Time]bool, len(completed))
+	for _, date := range completed {
+		if date = DateOf(date); !date.Before(report.StartsOn) && !date.After(to) {
+			logged[date] = true
+		}
+	}
+
+	if h.IsQuit() {
+		// Каждый день окна без срыва засчитывается, включая сегодняшний
+		days := DaysBetween(report.StartsOn, to) + 1
+		report.Missed = len(logged)
+		report.Done = days - report.Missed
+		report.Rate = float64(report.Done) / float64(days) * 100
+		return report, nil
+	}
+
+	// Сегодняшний день еще можно выполнить, поэтому пропуском он не считается
+	missedTo := to
+	if !report.Finished {
+		missedTo = today.AddDate(0, 0, -1)
+	}
+	report.Missed = len(h.MissedDays(report.StartsOn, missedTo, logged))
+
+	if h.IsQuota() {
+		report.Done = len(logged)
+		report.Rate = h.QuotaCompletionRate(completed, report.StartsOn, to)
+		return report, nil
+	}
+
+	for _, day := range h.ScheduledDaysBetween(report.StartsOn, to) {
+		switch {
+		case logged[day]:
+			report.Done++
+		case h.IsSkippedOn(day):
+			report.Skipped++
+		}
+	}
+	if counted := report.Done + report.Missed; counted > 0 {
+		report.Rate = float64(report.Done) / float64(counted) * 100
+	}
+	return report, nil
+}
+
+// HabitEventChallengeEnded челлендж завершился, в событии - итоговый отчет
+const HabitEventChallengeEnded HabitEventType = "challenge.ended"
+
+// NewChallengeEndedEvent создает событие завершения челленджа с итоговым отчетом
+func NewChallengeEndedEvent(h *Habit, report *ChallengeReport) *HabitEvent {
+	// Маршалинг структуры из простых полей не может завершиться ошибкой
+	payload, _ := json.Marshal(report)
+	return &HabitEvent{
+		HabitID:   h.ID,
+		UserID:    h.UserID,
+		Type:      HabitEventChallengeEnded,
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	}
+}
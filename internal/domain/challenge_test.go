@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// challengeHabit возвращает привычку h с окном челленджа [start, end]
+func challengeHabit(h *Habit, start, end string) *Habit {
+	if err := h.SetChallenge(date(start), date(end)); err != nil {
+		panic(err)
+	}
+	return h
+}
+
+func TestChallengeReport(t *testing.T) {
+	tests := []struct {
+		name         string
+		habit        func() *Habit
+		completed    []time.Time
+		today        string
+		wantFinished bool
+		wantDone     int
+		wantMissed   int
+		wantRate     float64
+	}{
+		{
+			name:  "logs outside the window are ignored",
+			habit: func() *Habit { return challengeHabit(dailyHabit(), "2026-01-05", "2026-01-11") },
+			// 3 января до окна, 12 января после него
+			completed:    dates("2026-01-03", "2026-01-05", "2026-01-06", "2026-01-07", "2026-01-12"),
+			today:        "2026-01-20",
+			wantFinished: true,
+			wantDone:     3,
+			wantMissed:   4,
+			wantRate:     3.0 / 7 * 100,
+		},
+		{
+			name:       "in progress does not count today as missed",
+			habit:      func() *Habit { return challengeHabit(dailyHabit(), "2026-01-05", "2026-01-11") },
+			completed:  dates("2026-01-05", "2026-01-06"),
+			today:      "2026-01-08",
+			wantDone:   2,
+			wantMissed: 1,
+			wantRate:   2.0 / 3 * 100,
+		},
+		{
+			name:  "quit habit counts clean days",
+			habit: func() *Habit { return challengeHabit(quitHabit(), "2026-01-05", "2026-01-11") },
+			// Два срыва в один день считаются одним пропущенным днем
+			completed:    dates("2026-01-02", "2026-01-06", "2026-01-06", "2026-01-09"),
+			today:        "2026-01-20",
+			wantFinished: true,
+			wantDone:     5,
+			wantMissed:   2,
+			wantRate:     5.0 / 7 * 100,
+		},
+		{
+			name: "window without scheduled days",
+			habit: func() *Habit {
+				h := dailyHabit()
+				h.Frequency = FrequencyWeekly
+				h.WeeklyDays = sql.NullString{String: "1", Valid: true}
+				// Вт - Сб, ни одного понедельника
+				return challengeHabit(h, "2026-01-06", "2026-01-10")
+			},
+			today:        "2026-01-20",
+			wantFinished: true,
+		},
+		{
+			name:      "not started yet",
+			habit:     func() *Habit { return challengeHabit(dailyHabit(), "2026-01-05", "2026-01-11") },
+			completed: dates("2026-01-03"),
+			today:     "2026-01-04",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := tt.habit().ChallengeReport(tt.completed, date(tt.today))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if report.Finished != tt.wantFinished {
+				t.Errorf("Finished = %v, want %v", report.Finished, tt.wantFinished)
+			}
+			if report.Done != tt.wantDone || report.Missed != tt.wantMissed {
+				t.Errorf("done/missed = %d/%d, want %d/%d", report.Done, report.Missed, tt.wantDone, tt.wantMissed)
+			}
+			if math.Abs(report.Rate-tt.wantRate) > 1e-9 {
+				t.Errorf("Rate = %v, want %v", report.Rate, tt.wantRate)
+			}
+		})
+	}
+}
+
+func TestChallengeReportNotChallenge(t *testing.T) {
+	if _, err := dailyHabit().ChallengeReport(nil, date("2026-01-10")); !errors.Is(err, ErrNotChallenge) {
+		t.Errorf("err = %v, want ErrNotChallenge", err)
+	}
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// DateOf возвращает календарную дату момента t в виде полуночи UTC.
+// Все даты (logged_date, reminder_date, reset_date и т.д.) хранятся как DATE,
+// поэтому сравниваются между собой именно в таком представлении.
+func DateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DaysBetween возвращает число календарных дней от from до to (отрицательное, если to раньше)
+func DaysBetween(from, to time.Time) int {
+	return int(DateOf(to).Sub(DateOf(from)).Hours() / 24)
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidGraduation возвращается при некорректных критериях выработки привычки
+var ErrInvalidGraduation = errors.New("graduation streak must be at least 1, rate must be 1-100 over at least 1 week")
+
+// SetGraduation задает критерии, по которым привычка считается выработанной: стрик не меньше streak
+// или процент выполнения не ниже rate за последние weeks недель. Нулевые streak и rate отключают критерий.
+func (h *Habit) SetGraduation(streak, rate, weeks int) error {
+	if streak < 0 || rate < 0 || rate > 100 || (rate > 0 && weeks < 1) {
+		return ErrInvalidGraduation
+	}
+	if rate > 0 && h.IsQuit() {
+		return fmt.Errorf("%w: quit habits graduate by clean streak only", ErrInvalidGraduation)
+	}
+
+	h.GraduationStreak = sql.NullInt32{Int32: int32(streak), Valid: streak > 0}
+	h.GraduationRate = sql.NullInt32{Int32: int32(rate), Valid: rate > 0}
+	h.GraduationWeeks = sql.NullInt32{Int32: int32(weeks), Valid: rate > 0}
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// HasGraduation проверяет, заданы ли привычке критерии выработки
+func (h *Habit) HasGraduation() bool {
+	return h.GraduationStreak.Valid || h.GraduationRate.Valid
+}
+
+// GraduationWindow возвращает интервал критерия процента выполнения: GraduationWeeks недель, закончившихся вчера.
+// false - критерий не задан или привычка создана позже начала интервала.
+func (h *Habit) GraduationWindow(today time.Time) (from, to time.Time, ok bool) {
+	if !h.GraduationRate.Valid {
+		return time.Time{}, time.Time{}, false
+	}
+	to = DateOf(today).AddDate(0, 0, -1)
+	from = to.AddDate(0, 0, 1-7*int(h.GraduationWeeks.Int32))
+	if DateOf(h.CreatedAt).After(from) {
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+// MeetsGraduation проверяет критерии выработки по текущему стрику и проценту выполнения rate
+// за GraduationWindow; rateKnown false - процент не посчитан и критерий не учитывается
+func (h *Habit) MeetsGraduation(rate float64, rateKnown bool) bool {
+	if h.GraduationStreak.Valid && h.CurrentStreak >= int(h.GraduationStreak.Int32) {
+		return true
+	}
+	return rateKnown && h.GraduationRate.Valid && rate >= float64(h.GraduationRate.Int32)
+}
+
+// HabitEventType тип события привычки
+type HabitEventType string
+
+// HabitEventFormed привычка выработана
+const HabitEventFormed HabitEventType = "habit.formed"
+
+// HabitEvent событие привычки для внешних потребителей.
+// Сохраняется в outbox вместе с изменением привычки и публикуется отдельно.
+type HabitEvent struct {
+	ID          int             `db:"id"`
+	HabitID     int             `db:"habit_id"`
+	UserID      int             `db:"user_id"`
+	Type        HabitEventType  `db:"event_type"`
+	Payload     json.RawMessage `db:"payload"`
+	CreatedAt   time.Time       `db:"created_at"`
+	PublishedAt sql.NullTime    `db:"published_at"`
+}
+
+// habitFormedPayload содержимое события habit.formed
+type habitFormedPayload struct {
+	HabitID        int       `json:"habit_id"`
+	Name           string    `json:"name"`
+	CurrentStreak  int       `json:"current_streak"`
+	BestStreak     int       `json:"best_streak"`
+	CompletionRate float64   `json:"completion_rate,omitempty"`
+	FormedAt       time.Time `json:"formed_at"`
+}
+
+// NewHabitFormedEvent создает событие выработки привычки; rate - процент выполнения за GraduationWindow, если посчитан
+func NewHabitFormedEvent(h *Habit, rate float64) *HabitEvent {
+	// Маршалинг структуры из простых полей не может завершиться ошибкой
+	payload, _ := json.Marshal(habitFormedPayload{
+		HabitID:        h.ID,
+		Name:           h.Name,
+		CurrentStreak:  h.CurrentStreak,
+		BestStreak:     h.BestStreak,
+		CompletionRate: rate,
+		FormedAt:       h.CompletedAt.Time,
+	})
+	return &HabitEvent{
+		HabitID:   h.ID,
+		UserID:    h.UserID,
+		Type:      HabitEventFormed,
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	}
+}
@@ -2,9 +2,13 @@ package domain
 
 import (
 	"database/sql"
+	"errors"
 	"time"
 )
 
+// ErrInvalidInterval возвращается при интервале меньше одного дня
+var ErrInvalidInterval = errors.New("interval must be at least 1 day")
+
 // HabitFrequency тип частоты привычки
 type HabitFrequency string
 
@@ -12,6 +16,12 @@ const (
 	FrequencyDaily   HabitFrequency = "daily"
 	FrequencyWeekly  HabitFrequency = "weekly"
 	FrequencyMonthly HabitFrequency = "monthly"
+	// FrequencyInterval каждые IntervalDays дней, начиная с AnchorDate
+	FrequencyInterval HabitFrequency = "interval"
+	// FrequencyQuota QuotaTarget раз за календарную неделю или месяц, в любые дни
+	FrequencyQuota HabitFrequency = "quota"
+	// FrequencyQuit отказ от вредной привычки: не планируется, стрик - дни с последнего срыва
+	FrequencyQuit HabitFrequency = "quit"
 )
 
 // Habit представляет привычку пользователя
@@ -33,6 +43,36 @@ type Habit struct {
 	CreatedAt         time.Time          `db:"created_at"`
 	UpdatedAt         time.Time          `db:"updated_at"`
 	CompletedAt       sql.NullTime       `db:"completed_at"`
+	IntervalDays      sql.NullInt32      `db:"interval_days"`
+	AnchorDate        sql.NullTime       `db:"anchor_date"`
+	QuotaTarget       sql.NullInt32      `db:"quota_target"`
+	QuotaPeriod       sql.NullString     `db:"quota_period"`
+	// RRule правило RFC 5545; если задано, определяет расписание вместо Frequency
+	RRule             sql.NullString     `db:"rrule"`
+	RRuleStart        sql.NullTime       `db:"rrule_start"`
+	// TargetValue дневная цель количественной привычки в единицах Unit
+	TargetValue       sql.NullFloat64    `db:"target_value"`
+	Unit              sql.NullString     `db:"unit"`
+	DailyCount        sql.NullInt32      `db:"daily_count"`
+	// Критерии выработки: стрик GraduationStreak или GraduationRate% выполнения за GraduationWeeks недель
+	GraduationStreak  sql.NullInt32      `db:"graduation_streak"`
+	GraduationRate    sql.NullInt32      `db:"graduation_rate"`
+	GraduationWeeks   sql.NullInt32      `db:"graduation_weeks"`
+	// Окно челленджа: привычка планируется только с StartsOn по EndsOn включительно
+	StartsOn          sql.NullTime       `db:"starts_on"`
+	EndsOn            sql.NullTime       `db:"ends_on"`
+
+	// pauses паузы привычки, загружаются сервисом перед расчетом расписания
+	pauses []*Pause
+	// frozenDays дни, пропуск в которые закрыт заморозкой стрика
+	frozenDays map[time.Time]bool
+	// skippedDays дни с уважительным пропуском
+	skippedDays map[time.Time]bool
+	// revisions редакции расписания по возрастанию EffectiveFrom
+	revisions []*ScheduleRevision
+	// rule разобранное RRule, ruleSource - строка, из которой оно разобрано
+	rule       *RRule
+	ruleSource string
 }
 
 // NewHabit создает новую привычку
@@ -71,12 +111,35 @@ func (h *Habit) SetWeeklyDays(days string) {
 }
 
 // SetMonthlyDays устанавливает дни месяца (для ежемесячных привычек)
-// days формат: "1,15,28"
+// days формат: "1,15,31,last,2TUE" - см. MonthlyRule
 func (h *Habit) SetMonthlyDays(days string) {
 	h.MonthlyDays = sql.NullString{String: days, Valid: days != ""}
 	h.UpdatedAt = time.Now()
 }
 
+// SetInterval устанавливает интервал в днях и дату отсчета (для привычек "каждые N дней")
+func (h *Habit) SetInterval(days int, anchor time.Time) error {
+	if days < 1 {
+		return ErrInvalidInterval
+	}
+	h.IntervalDays = sql.NullInt32{Int32: int32(days), Valid: true}
+	h.AnchorDate = sql.NullTime{Time: DateOf(anchor), Valid: true}
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetRRule задает правило повторения с началом start (DTSTART); nil убирает правило
+func (h *Habit) SetRRule(rule *RRule, start time.Time) {
+	if rule == nil {
+		h.RRule = sql.NullString{}
+		h.RRuleStart = sql.NullTime{}
+	} else {
+		h.RRule = sql.NullString{String: rule.String(), Valid: true}
+		h.RRuleStart = sql.NullTime{Time: DateOf(start), Valid: true}
+	}
+	h.UpdatedAt = time.Now()
+}
+
 // Deactivate деактивирует привычку
 func (h *Habit) Deactivate() {
 	h.IsActive = false
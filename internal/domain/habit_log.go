@@ -13,6 +13,10 @@ type HabitLog struct {
 	Comment   sql.NullString `db:"comment"`
 	LoggedDate time.Time     `db:"logged_date"`
 	LoggedAt  time.Time      `db:"logged_at"`
+	// Value накопленное за день значение количественной привычки
+	Value     sql.NullFloat64 `db:"value"`
+	// CompletionNumber номер выполнения за день, начиная с 1
+	CompletionNumber int `db:"completion_number"`
 }
 
 // NewHabitLog создает новый лог выполнения привычки
@@ -23,9 +27,23 @@ func NewHabitLog(habitID, userID int, loggedDate time.Time, comment string) *Hab
 		LoggedDate: loggedDate,
 		Comment:   sql.NullString{String: comment, Valid: comment != ""},
 		LoggedAt:  time.Now(),
+		CompletionNumber: 1,
 	}
 }
 
+// AddValue прибавляет к логу частичное выполнение за тот же день
+func (hl *HabitLog) AddValue(value float64) {
+	hl.Value = sql.NullFloat64{Float64: hl.Value.Float64 + value, Valid: true}
+}
+
+// GetValue возвращает значение или 0
+func (hl *HabitLog) GetValue() float64 {
+	if hl.Value.Valid {
+		return hl.Value.Float64
+	}
+	return 0
+}
+
 // GetComment возвращает комментарий или пустую строку
 func (hl *HabitLog) GetComment() string {
 	if hl.Comment.Valid {
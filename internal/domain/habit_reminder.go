@@ -13,6 +13,8 @@ type HabitReminder struct {
 	ReminderDate sql.NullTime   `db:"reminder_date"`
 	IsCompleted  bool           `db:"is_completed"`
 	SentAt       time.Time      `db:"sent_at"`
+	// IsSkipped день пропущен по уважительной причине
+	IsSkipped    bool           `db:"is_skipped"`
 }
 
 // NewHabitReminder создает новое напоминание
@@ -29,6 +31,13 @@ func NewHabitReminder(habitID, userID int, reminderDate time.Time) *HabitReminde
 // MarkAsCompleted отмечает напоминание как выполненное
 func (hr *HabitReminder) MarkAsCompleted() {
 	hr.IsCompleted = true
+	hr.IsSkipped = false
+}
+
+// MarkAsSkipped отмечает напоминание как пропущенное по уважительной причине
+func (hr *HabitReminder) MarkAsSkipped() {
+	hr.IsCompleted = false
+	hr.IsSkipped = true
 }
 
 // MarkAsIncomplete отмечает напоминание как невыполненное
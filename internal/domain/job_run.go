@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"database/sql"
+	"time"
+)
+
+// JobRunTrigger причина запуска задачи scheduler
+type JobRunTrigger string
+
+const (
+	JobRunTriggerSchedule JobRunTrigger = "schedule"
+	JobRunTriggerManual   JobRunTrigger = "manual"
+	JobRunTriggerCatchUp  JobRunTrigger = "catch_up"
+)
+
+// JobRunStatus статус запуска задачи scheduler
+type JobRunStatus string
+
+const (
+	JobRunStatusRunning   JobRunStatus = "running"
+	JobRunStatusSucceeded JobRunStatus = "succeeded"
+	JobRunStatusFailed    JobRunStatus = "failed"
+)
+
+// JobRun представляет один запуск периодической задачи
+type JobRun struct {
+	ID              int            `db:"id"`
+	JobName         string         `db:"job_name"`
+	Trigger         JobRunTrigger  `db:"trigger"`
+	Status          JobRunStatus   `db:"status"`
+	StartedAt       time.Time      `db:"started_at"`
+	FinishedAt      sql.NullTime   `db:"finished_at"`
+	UsersProcessed  int            `db:"users_processed"`
+	HabitsProcessed int            `db:"habits_processed"`
+	FailedCount     int            `db:"failed_count"`
+	Error           sql.NullString `db:"error"`
+}
+
+// NewJobRun создает запись о начавшемся запуске задачи
+func NewJobRun(jobName string, trigger JobRunTrigger) *JobRun {
+	return &JobRun{
+		JobName:   jobName,
+		Trigger:   trigger,
+		Status:    JobRunStatusRunning,
+		StartedAt: time.Now(),
+	}
+}
+
+// Finish завершает запуск с результатом err
+func (r *JobRun) Finish(err error) {
+	r.FinishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err != nil {
+		r.Status = JobRunStatusFailed
+		r.Error = sql.NullString{String: err.Error(), Valid: true}
+		return
+	}
+	r.Status = JobRunStatusSucceeded
+}
+
+// Duration возвращает длительность завершенного запуска или 0
+func (r *JobRun) Duration() time.Duration {
+	if !r.FinishedAt.Valid {
+		return 0
+	}
+	return r.FinishedAt.Time.Sub(r.StartedAt)
+}
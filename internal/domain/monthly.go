@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidMonthlyRule возвращается при некорректном правиле ежемесячного расписания
+var ErrInvalidMonthlyRule = errors.New("invalid monthly rule")
+
+// weekdayCodes коды дней недели в правилах вида "2TUE"
+var weekdayCodes = map[string]time.Weekday{
+	"MON": time.Monday,
+	"TUE": time.Tuesday,
+	"WED": time.Wednesday,
+	"THU": time.Thursday,
+	"FRI": time.Friday,
+	"SAT": time.Saturday,
+	"SUN": time.Sunday,
+}
+
+// MonthlyRule одно правило ежемесячного расписания:
+// день месяца (1-31), последний день месяца или N-й день недели месяца
+type MonthlyRule struct {
+	// Day день месяца 1-31; в коротких месяцах переносится на последний день
+	Day int
+	// Last последний день месяца
+	Last bool
+	// Ordinal номер дня недели в месяце 1-5, -1 - последний; 0 - правило не по дню недели
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// ParseMonthlyRule разбирает правило: "15", "last", "2nd TUE", "2TUE", "last FRI", "-1FRI"
+func ParseMonthlyRule(s string) (MonthlyRule, error) {
+	token := strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	if token == "" {
+		return MonthlyRule{}, fmt.Errorf("%w: empty", ErrInvalidMonthlyRule)
+	}
+
+	if token == "LAST" {
+		return MonthlyRule{Last: true}, nil
+	}
+
+	if day, err := strconv.Atoi(token); err == nil {
+		if day < 1 || day > 31 {
+			return MonthlyRule{}, fmt.Errorf("%w: day %d is out of range 1-31", ErrInvalidMonthlyRule, day)
+		}
+		return MonthlyRule{Day: day}, nil
+	}
+
+	if len(token) < 4 {
+		return MonthlyRule{}, fmt.Errorf("%w: %q", ErrInvalidMonthlyRule, s)
+	}
+	weekday, ok := weekdayCodes[token[len(token)-3:]]
+	if !ok {
+		return MonthlyRule{}, fmt.Errorf("%w: unknown weekday in %q", ErrInvalidMonthlyRule, s)
+	}
+
+	ordinal, err := parseOrdinal(token[:len(token)-3])
+	if err != nil {
+		return MonthlyRule{}, fmt.Errorf("%w: %q", ErrInvalidMonthlyRule, s)
+	}
+
+	return MonthlyRule{Ordinal: ordinal, Weekday: weekday}, nil
+}
+
+// parseOrdinal разбирает "1", "1ST", "2ND", "3RD", "4TH", "5TH", "LAST", "-1"
+func parseOrdinal(s string) (int, error) {
+	if s == "LAST" || s == "-1" {
+		return -1, nil
+	}
+	for _, suffix := range []string{"ST", "ND", "RD", "TH"} {
+		s = strings.TrimSuffix(s, suffix)
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > 5 {
+		return 0, fmt.Errorf("invalid ordinal %q", s)
+	}
+	return n, nil
+}
+
+// String возвращает правило в каноническом виде для хранения в monthly_days
+func (r MonthlyRule) String() string {
+	switch {
+	case r.Last:
+		return "last"
+	case r.Ordinal != 0:
+		for code, wd := range weekdayCodes {
+			if wd == r.Weekday {
+				return strconv.Itoa(r.Ordinal) + code
+			}
+		}
+	}
+	return strconv.Itoa(r.Day)
+}
+
+// Matches проверяет, попадает ли дата под правило
+func (r MonthlyRule) Matches(date time.Time) bool {
+	lastDay := daysInMonth(date)
+
+	switch {
+	case r.Last:
+		return date.Day() == lastDay
+
+	case r.Ordinal > 0:
+		return date.Weekday() == r.Weekday && (date.Day()-1)/7+1 == r.Ordinal
+
+	case r.Ordinal < 0:
+		return date.Weekday() == r.Weekday && date.Day()+7 > lastDay
+
+	default:
+		return date.Day() == min(r.Day, lastDay)
+	}
+}
+
+// ParseMonthlyDays разбирает список правил через запятую: "1,15,last,2nd TUE"
+func ParseMonthlyDays(s string) ([]MonthlyRule, error) {
+	var rules []MonthlyRule
+	for _, part := range strings.Split(s, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		rule, err := ParseMonthlyRule(part)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// FormatMonthlyDays собирает правила в строку monthly_days
+func FormatMonthlyDays(rules []MonthlyRule) string {
+	parts := make([]string, len(rules))
+	for i, rule := range rules {
+		parts[i] = rule.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// daysInMonth возвращает число дней в месяце даты
+func daysInMonth(date time.Time) int {
+	return time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseMonthlyRule(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    MonthlyRule
+		wantErr bool
+	}{
+		{in: "15", want: MonthlyRule{Day: 15}},
+		{in: "31", want: MonthlyRule{Day: 31}},
+		{in: "last", want: MonthlyRule{Last: true}},
+		{in: "2nd TUE", want: MonthlyRule{Ordinal: 2, Weekday: time.Tuesday}},
+		{in: "2TUE", want: MonthlyRule{Ordinal: 2, Weekday: time.Tuesday}},
+		{in: "1st mon", want: MonthlyRule{Ordinal: 1, Weekday: time.Monday}},
+		{in: "last FRI", want: MonthlyRule{Ordinal: -1, Weekday: time.Friday}},
+		{in: "-1FRI", want: MonthlyRule{Ordinal: -1, Weekday: time.Friday}},
+		{in: "0", wantErr: true},
+		{in: "32", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "6MON", wantErr: true},
+		{in: "2XYZ", wantErr: true},
+		{in: "MON", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMonthlyRule(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidMonthlyRule) {
+					t.Fatalf("ParseMonthlyRule(%q) error = %v, want ErrInvalidMonthlyRule", tt.in, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMonthlyRule(%q) error = %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMonthlyRule(%q) = %+v, want %+v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthlyRuleMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		date string
+		want bool
+	}{
+		{"plain day", "15", "2026-01-15", true},
+		{"other day", "15", "2026-01-16", false},
+		{"31 clamped to february end", "31", "2026-02-28", true},
+		{"31 clamped to april end", "31", "2026-04-30", true},
+		{"31 in a long month", "31", "2026-01-30", false},
+		{"30 clamped in leap february", "30", "2028-02-29", true},
+		{"last day", "last", "2026-02-28", true},
+		{"not last day", "last", "2026-02-27", false},
+		{"second tuesday", "2TUE", "2026-01-13", true},
+		{"first tuesday is not second", "2TUE", "2026-01-06", false},
+		{"fifth monday", "5MON", "2026-03-30", true},
+		{"last friday", "-1FRI", "2026-01-30", true},
+		{"friday a week before last", "-1FRI", "2026-01-23", false},
+		{"last friday of february", "-1FRI", "2026-02-27", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseMonthlyRule(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := rule.Matches(date(tt.date)); got != tt.want {
+				t.Errorf("%s.Matches(%s) = %v, want %v", tt.rule, tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthlyDaysRoundTrip(t *testing.T) {
+	rules, err := ParseMonthlyDays("1, 15,last,2nd TUE,-1FRI")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := FormatMonthlyDays(rules), "1,15,last,2TUE,-1FRI"; got != want {
+		t.Errorf("FormatMonthlyDays = %q, want %q", got, want)
+	}
+}
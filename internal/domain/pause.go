@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrInvalidPausePeriod возвращается, если конец паузы раньше начала
+	ErrInvalidPausePeriod = errors.New("pause end date is before start date")
+	// ErrPauseAlreadyCancelled возвращается при повторной отмене паузы
+	ErrPauseAlreadyCancelled = errors.New("pause is already cancelled")
+)
+
+// Pause период, в который привычка не запланирована: не напоминается, не обрывает стрик
+// и не учитывается в проценте выполнения. Пауза без HabitID - отпуск, покрывает все привычки пользователя.
+type Pause struct {
+	ID          int            `db:"id"`
+	UserID      int            `db:"user_id"`
+	HabitID     sql.NullInt32  `db:"habit_id"`
+	StartDate   time.Time      `db:"start_date"`
+	EndDate     time.Time      `db:"end_date"`
+	Reason      sql.NullString `db:"reason"`
+	CreatedAt   time.Time      `db:"created_at"`
+	CancelledAt sql.NullTime   `db:"cancelled_at"`
+}
+
+// NewPause создает паузу на даты [start, end]; habitID 0 означает отпуск по всем привычкам
+func NewPause(userID, habitID int, start, end time.Time, reason string) (*Pause, error) {
+	start, end = DateOf(start), DateOf(end)
+	if end.Before(start) {
+		return nil, ErrInvalidPausePeriod
+	}
+	return &Pause{
+		UserID:    userID,
+		HabitID:   sql.NullInt32{Int32: int32(habitID), Valid: habitID != 0},
+		StartDate: start,
+		EndDate:   end,
+		Reason:    sql.NullString{String: reason, Valid: reason != ""},
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// IsVacation проверяет, относится ли пауза ко всем привычкам пользователя
+func (p *Pause) IsVacation() bool {
+	return !p.HabitID.Valid
+}
+
+// AppliesTo проверяет, действует ли пауза на привычку
+func (p *Pause) AppliesTo(habit *Habit) bool {
+	if p.UserID != habit.UserID {
+		return false
+	}
+	return p.IsVacation() || int(p.HabitID.Int32) == habit.ID
+}
+
+// Covers проверяет, приходится ли дата на паузу
+func (p *Pause) Covers(date time.Time) bool {
+	date = DateOf(date)
+	return !date.Before(p.StartDate) && !date.After(p.EndDate)
+}
+
+// Cancel отменяет паузу в день today. Уже прошедшие дни паузы остаются на паузе,
+// чтобы отмена не обрывала стрик задним числом; не начавшаяся пауза перестает покрывать какие-либо дни.
+func (p *Pause) Cancel(today time.Time) error {
+	if p.CancelledAt.Valid {
+		return ErrPauseAlreadyCancelled
+	}
+	today = DateOf(today)
+	if p.StartDate.Before(today) {
+		if yesterday := today.AddDate(0, 0, -1); p.EndDate.After(yesterday) {
+			p.EndDate = yesterday
+		}
+	} else {
+		p.EndDate = p.StartDate.AddDate(0, 0, -1)
+	}
+	p.CancelledAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return nil
+}
+
+// SetPauses запоминает паузы, действующие на привычку; остальные паузы игнорируются
+func (h *Habit) SetPauses(pauses []*Pause) {
+	h.pauses = nil
+	for _, p := range pauses {
+		if p.AppliesTo(h) {
+			h.pauses = append(h.pauses, p)
+		}
+	}
+}
+
+// IsPausedOn проверяет, стоит ли привычка на паузе в дату
+func (h *Habit) IsPausedOn(date time.Time) bool {
+	for _, p := range h.pauses {
+		if p.Covers(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPausedBetween проверяет, есть ли в интервале [from, to] хотя бы один день на паузе
+func (h *Habit) isPausedBetween(from, to time.Time) bool {
+	for _, p := range h.pauses {
+		if !p.StartDate.After(to) && !p.EndDate.Before(from) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrInvalidTarget возвращается при неположительной цели количественной привычки
+	ErrInvalidTarget = errors.New("target value must be positive")
+	// ErrInvalidLogValue возвращается при неположительном значении лога количественной привычки
+	ErrInvalidLogValue = errors.New("log value must be positive")
+	// ErrInvalidDailyCount возвращается при числе выполнений за день меньше 1
+	ErrInvalidDailyCount = errors.New("daily count must be at least 1")
+)
+
+// SetTarget задает дневную цель количественной привычки: "20 страниц", "2 литра"; 0 делает привычку бинарной
+func (h *Habit) SetTarget(value float64, unit string) error {
+	if value < 0 {
+		return ErrInvalidTarget
+	}
+	if value > 0 && h.GetDailyCount() > 1 {
+		return fmt.Errorf("%w: habit already has a daily count", ErrInvalidTarget)
+	}
+	if value == 0 {
+		h.TargetValue = sql.NullFloat64{}
+		h.Unit = sql.NullString{}
+	} else {
+		h.TargetValue = sql.NullFloat64{Float64: value, Valid: true}
+		h.Unit = sql.NullString{String: unit, Valid: unit != ""}
+	}
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsQuantitative проверяет, есть ли у привычки числовая дневная цель
+func (h *Habit) IsQuantitative() bool {
+	return h.TargetValue.Valid
+}
+
+// SetDailyCount задает, сколько раз за день нужно выполнить привычку: "медитировать дважды в день"
+func (h *Habit) SetDailyCount(count int) error {
+	if count < 1 {
+		return ErrInvalidDailyCount
+	}
+	if count > 1 && h.IsQuantitative() {
+		return fmt.Errorf("%w: quantitative habits accumulate a value instead", ErrInvalidDailyCount)
+	}
+	if count == 1 {
+		h.DailyCount = sql.NullInt32{}
+	} else {
+		h.DailyCount = sql.NullInt32{Int32: int32(count), Valid: true}
+	}
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// GetDailyCount возвращает число выполнений за день, нужное для зачета дня
+func (h *Habit) GetDailyCount() int {
+	if h.DailyCount.Valid {
+		return int(h.DailyCount.Int32)
+	}
+	return 1
+}
+
+// DayProgress прогресс выполнения привычки за один день
+type DayProgress struct {
+	Count int
+	// Target число выполнений, нужное для зачета дня
+	Target    int
+	Total     float64
+	Completed bool
+}
+
+// Progress считает прогресс по логам одного дня
+func (h *Habit) Progress(dayLogs []*HabitLog) DayProgress {
+	progress := DayProgress{Count: len(dayLogs), Target: h.GetDailyCount()}
+	for _, log := range dayLogs {
+		progress.Total += log.GetValue()
+	}
+	progress.Completed = h.IsDayComplete(progress.Count, progress.Total)
+	return progress
+}
+
+// IsDayComplete проверяет, засчитывается ли день с count выполнениями и накопленным значением total.
+// У бинарной привычки засчитывается любой день с логом.
+func (h *Habit) IsDayComplete(count int, total float64) bool {
+	if count < h.GetDailyCount() {
+		return false
+	}
+	return !h.IsQuantitative() || total >= h.TargetValue.Float64
+}
+
+// CompletedDates возвращает дни, засчитанные как выполненные: для количественной привычки -
+// дни, в которые сумма значений логов достигла цели; для привычки с несколькими выполнениями -
+// дни, в которые набрано нужное число логов; для бинарной - все дни с логом
+func (h *Habit) CompletedDates(logs []*HabitLog) []time.Time {
+	byDay := make(map[time.Time][]*HabitLog)
+	var days []time.Time
+	for _, log := range logs {
+		day := DateOf(log.LoggedDate)
+		if _, ok := byDay[day]; !ok {
+			days = append(days, day)
+		}
+		byDay[day] = append(byDay[day], log)
+	}
+
+	completed := days[:0]
+	for _, day := range days {
+		if h.Progress(byDay[day]).Completed {
+			completed = append(completed, day)
+		}
+	}
+	return completed
+}
+
+// ValueStats сумма и среднее значений количественной привычки за период
+type ValueStats struct {
+	Total float64
+	// DailyAverage среднее за день с логом
+	DailyAverage float64
+	LoggedDays   int
+	Target       float64
+	Unit         string
+}
+
+// ComputeValueStats считает сумму значений логов привычки и среднее за день с логом
+func (h *Habit) ComputeValueStats(logs []*HabitLog) ValueStats {
+	stats := ValueStats{Target: h.TargetValue.Float64, Unit: h.Unit.String}
+	days := make(map[time.Time]bool)
+	for _, log := range logs {
+		stats.Total += log.GetValue()
+		days[DateOf(log.LoggedDate)] = true
+	}
+
+	stats.LoggedDays = len(days)
+	if stats.LoggedDays > 0 {
+		stats.DailyAverage = stats.Total / float64(stats.LoggedDays)
+	}
+	return stats
+}
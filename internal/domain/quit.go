@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	// ErrNotQuitHabit возвращается при записи срыва по привычке, которая не является отказом
+	ErrNotQuitHabit = errors.New("habit is not a quit habit")
+	// ErrInvalidStatsPeriod возвращается при разбивке статистики не по неделям или месяцам
+	ErrInvalidStatsPeriod = errors.New("stats period must be week or month")
+)
+
+// Relapse срыв по привычке, от которой пользователь отказывается
+type Relapse struct {
+	ID          int            `db:"id"`
+	HabitID     int            `db:"habit_id"`
+	UserID      int            `db:"user_id"`
+	RelapseDate time.Time      `db:"relapse_date"`
+	Note        sql.NullString `db:"note"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
+
+// NewRelapse создает запись о срыве в день date
+func NewRelapse(habitID, userID int, date time.Time, note string) *Relapse {
+	return &Relapse{
+		HabitID:     habitID,
+		UserID:      userID,
+		RelapseDate: DateOf(date),
+		Note:        sql.NullString{String: note, Valid: note != ""},
+		CreatedAt:   time.Now(),
+	}
+}
+
+// GetNote возвращает заметку или пустую строку
+func (r *Relapse) GetNote() string {
+	if r.Note.Valid {
+		return r.Note.String
+	}
+	return ""
+}
+
+// IsQuit проверяет, является ли привычка отказом от вредной привычки
+func (h *Habit) IsQuit() bool {
+	return h.Frequency == FrequencyQuit
+}
+
+// ComputeQuitStreak вычисляет стрик отказа на дату today: текущий стрик - дни без срыва
+// после последнего срыва (или с начала привычки), включая сегодняшний; лучший - самая
+// длинная чистая серия. Срыв в сам день обнуляет текущий стрик. После окончания челленджа
+// серия не растет.
+func (h *Habit) ComputeQuitStreak(relapseDates []time.Time, today time.Time) StreakState {
+	today = DateOf(today)
+	if h.IsChallengeOverOn(today) {
+		today = DateOf(h.EndsOn.Time)
+	}
+	dates := uniqueDatesUntil(relapseDates, today)
+
+	start := h.windowStart()
+	if len(dates) > 0 && dates[0].Before(start) {
+		start = dates[0]
+	}
+
+	var state StreakState
+	// prev - последний день перед текущей чистой серией
+	prev := start.AddDate(0, 0, -1)
+	for _, date := range dates {
+		state.BestStreak = max(state.BestStreak, DaysBetween(prev, date)-1)
+		prev = date
+	}
+
+	state.CurrentStreak = max(DaysBetween(prev, today), 0)
+	state.BestStreak = max(state.BestStreak, state.CurrentStreak)
+	if state.CurrentStreak > 0 {
+		state.StreakStart = prev.AddDate(0, 0, 1)
+	}
+	return state
+}
+
+// RelapsePeriodCount число отмеченных срывов за неделю или месяц
+type RelapsePeriodCount struct {
+	Start time.Time
+	Count int
+}
+
+// RelapseStats статистика отказа от привычки
+type RelapseStats struct {
+	CurrentCleanRun int
+	LongestCleanRun int
+	// TotalRelapses срывы за запрошенный период
+	TotalRelapses int
+	// Periods срывы по неделям или месяцам запрошенного периода
+	Periods []RelapsePeriodCount
+}
+
+// ComputeRelapseStats считает чистые серии по всей истории срывов и частоту срывов
+// в интервале [from, to] с разбивкой по period. Частота считается по отмеченным срывам,
+// а не по дням: несколько срывов за день учитываются каждый.
+func (h *Habit) ComputeRelapseStats(relapseDates []time.Time, from, to, today time.Time, period QuotaPeriod) RelapseStats {
+	state := h.ComputeQuitStreak(relapseDates, today)
+	stats := RelapseStats{
+		CurrentCleanRun: state.CurrentStreak,
+		LongestCleanRun: state.BestStreak,
+	}
+
+	from, to = DateOf(from), DateOf(to)
+	for day := from; !day.After(to); {
+		start, end := PeriodBounds(period, day)
+		if end.After(to) {
+			end = to
+		}
+		bucket := RelapsePeriodCount{Start: start}
+		for _, date := range relapseDates {
+			if date = DateOf(date); !date.Before(day) && !date.After(end) {
+				bucket.Count++
+			}
+		}
+		stats.TotalRelapses += bucket.Count
+		stats.Periods = append(stats.Periods, bucket)
+		day = end.AddDate(0, 0, 1)
+	}
+	return stats
+}
+
+// uniqueDatesUntil возвращает отсортированные различные даты не позже to
+func uniqueDatesUntil(dates []time.Time, to time.Time) []time.Time {
+	seen := make(map[time.Time]bool, len(dates))
+	var result []time.Time
+	for _, date := range dates {
+		date = DateOf(date)
+		if date.After(to) || seen[date] {
+			continue
+		}
+		seen[date] = true
+		result = append(result, date)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func quitHabit() *Habit {
+	h := dailyHabit()
+	h.Frequency = FrequencyQuit
+	return h
+}
+
+func TestComputeQuitStreak(t *testing.T) {
+	tests := []struct {
+		name        string
+		habit       func() *Habit
+		relapses    []time.Time
+		today       string
+		wantCurrent int
+		wantBest    int
+		wantStart   string
+	}{
+		{
+			name:        "no relapses counts from creation including today",
+			habit:       quitHabit,
+			today:       "2026-01-05",
+			wantCurrent: 5,
+			wantBest:    5,
+			wantStart:   "2026-01-01",
+		},
+		{
+			name:     "relapse today resets current streak",
+			habit:    quitHabit,
+			relapses: dates("2026-01-05"),
+			today:    "2026-01-05",
+			wantBest: 4,
+		},
+		{
+			name:        "clean run after relapse",
+			habit:       quitHabit,
+			relapses:    dates("2026-01-03"),
+			today:       "2026-01-05",
+			wantCurrent: 2,
+			wantBest:    2,
+			wantStart:   "2026-01-04",
+		},
+		{
+			name:        "best run between relapses",
+			habit:       quitHabit,
+			relapses:    dates("2026-01-08", "2026-01-02"),
+			today:       "2026-01-10",
+			wantCurrent: 2,
+			wantBest:    5,
+			wantStart:   "2026-01-09",
+		},
+		{
+			name:        "duplicate and future relapses are ignored",
+			habit:       quitHabit,
+			relapses:    dates("2026-01-03", "2026-01-03", "2026-01-20"),
+			today:       "2026-01-05",
+			wantCurrent: 2,
+			wantBest:    2,
+			wantStart:   "2026-01-04",
+		},
+		{
+			name:        "relapse before creation moves history start",
+			habit:       quitHabit,
+			relapses:    dates("2025-12-30"),
+			today:       "2026-01-05",
+			wantCurrent: 6,
+			wantBest:    6,
+			wantStart:   "2025-12-31",
+		},
+		{
+			name: "challenge window start",
+			habit: func() *Habit {
+				h := quitHabit()
+				h.StartsOn = sql.NullTime{Time: date("2026-01-05"), Valid: true}
+				return h
+			},
+			today:       "2026-01-07",
+			wantCurrent: 3,
+			wantBest:    3,
+			wantStart:   "2026-01-05",
+		},
+		{
+			name: "streak stops growing after challenge end",
+			habit: func() *Habit {
+				h := quitHabit()
+				h.EndsOn = sql.NullTime{Time: date("2026-01-10"), Valid: true}
+				return h
+			},
+			today:       "2026-01-20",
+			wantCurrent: 10,
+			wantBest:    10,
+			wantStart:   "2026-01-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.habit().ComputeQuitStreak(tt.relapses, date(tt.today))
+			if state.CurrentStreak != tt.wantCurrent || state.BestStreak != tt.wantBest {
+				t.Errorf("streak = %d/%d, want %d/%d", state.CurrentStreak, state.BestStreak, tt.wantCurrent, tt.wantBest)
+			}
+
+			var wantStart time.Time
+			if tt.wantStart != "" {
+				wantStart = date(tt.wantStart)
+			}
+			if !state.StreakStart.Equal(wantStart) {
+				t.Errorf("StreakStart = %v, want %v", state.StreakStart, wantStart)
+			}
+		})
+	}
+}
+
+func TestComputeRelapseStats(t *testing.T) {
+	// Два срыва 6 января учитываются оба, срыв 20 января - после to
+	relapses := dates("2025-12-30", "2026-01-03", "2026-01-06", "2026-01-06", "2026-01-13", "2026-01-20")
+	stats := quitHabit().ComputeRelapseStats(relapses, date("2026-01-01"), date("2026-01-18"), date("2026-01-18"), QuotaPeriodWeek)
+
+	if stats.CurrentCleanRun != 5 || stats.LongestCleanRun != 6 {
+		t.Errorf("clean runs = %d/%d, want 5/6", stats.CurrentCleanRun, stats.LongestCleanRun)
+	}
+	if stats.TotalRelapses != 4 {
+		t.Errorf("TotalRelapses = %d, want 4", stats.TotalRelapses)
+	}
+
+	// Первая неделя начинается до from, но срывы до from в нее не попадают
+	want := []RelapsePeriodCount{
+		{Start: date("2025-12-29"), Count: 1},
+		{Start: date("2026-01-05"), Count: 2},
+		{Start: date("2026-01-12"), Count: 1},
+	}
+	if len(stats.Periods) != len(want) {
+		t.Fatalf("Periods = %v, want %v", stats.Periods, want)
+	}
+	for i := range want {
+		if !stats.Periods[i].Start.Equal(want[i].Start) || stats.Periods[i].Count != want[i].Count {
+			t.Errorf("Periods[%d] = %+v, want %+v", i, stats.Periods[i], want[i])
+		}
+	}
+}
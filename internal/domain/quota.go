@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// QuotaPeriod календарный период, за который должна быть выполнена квота
+type QuotaPeriod string
+
+const (
+	QuotaPeriodWeek  QuotaPeriod = "week"
+	QuotaPeriodMonth QuotaPeriod = "month"
+)
+
+// ErrInvalidQuota возвращается при некорректной квоте
+var ErrInvalidQuota = errors.New("quota target must be at least 1 and period must be week or month")
+
+// SetQuota устанавливает квоту "target раз за период" (для привычек с частотой quota)
+func (h *Habit) SetQuota(target int, period QuotaPeriod) error {
+	if err := ValidateQuota(target, period); err != nil {
+		return err
+	}
+	h.QuotaTarget = sql.NullInt32{Int32: int32(target), Valid: true}
+	h.QuotaPeriod = sql.NullString{String: string(period), Valid: true}
+	h.UpdatedAt = time.Now()
+	return nil
+}
+
+// ValidateQuota проверяет квоту до создания привычки
+func ValidateQuota(target int, period QuotaPeriod) error {
+	if target < 1 || (period != QuotaPeriodWeek && period != QuotaPeriodMonth) {
+		return ErrInvalidQuota
+	}
+	return nil
+}
+
+// IsQuota проверяет, выполняется ли привычка по квоте за период, а не по дням
+func (h *Habit) IsQuota() bool {
+	return h.Frequency == FrequencyQuota
+}
+
+// QuotaPeriodBounds возвращает первый и последний день периода квоты, действовавшей в день date, содержащего date
+func (h *Habit) QuotaPeriodBounds(date time.Time) (start, end time.Time) {
+	schedule, _ := h.scheduleOn(date)
+	return PeriodBounds(QuotaPeriod(schedule.QuotaPeriod.String), date)
+}
+
+// PeriodBounds возвращает первый и последний день календарной недели или месяца, содержащих date.
+// Неделя начинается с понедельника.
+func PeriodBounds(period QuotaPeriod, date time.Time) (start, end time.Time) {
+	date = DateOf(date)
+	if period == QuotaPeriodMonth {
+		start = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, -1)
+	}
+	start = date.AddDate(0, 0, 1-WeekdayNumber(date.Weekday()))
+	return start, start.AddDate(0, 0, 6)
+}
+
+// IsQuotaMet проверяет, выполнена ли квота, действовавшая в день date, за период, содержащий date
+func (h *Habit) IsQuotaMet(date time.Time, logged map[time.Time]bool) bool {
+	schedule, _ := h.scheduleOn(date)
+	start, end := h.QuotaPeriodBounds(date)
+	return countLogged(logged, start, end) >= int(schedule.QuotaTarget.Int32)
+}
+
+// QuotaCompletionRate возвращает процент выполнения квоты за периоды, пересекающиеся с [from, to].
+// Учитываются только логи внутри [from, to]; перевыполнение периода не засчитывается в другие.
+// Каждый период оценивается по квоте, действовавшей в его начале.
+func (h *Habit) QuotaCompletionRate(loggedDates []time.Time, from, to time.Time) float64 {
+	from, to = DateOf(from), DateOf(to)
+	logged := make(map[time.Time]bool, len(loggedDates))
+	for _, date := range loggedDates {
+		if date = DateOf(date); !date.Before(from) && !date.After(to) {
+			logged[date] = true
+		}
+	}
+
+	expected, done := 0, 0
+	for day := from; !day.After(to); {
+		schedule, _ := h.scheduleOn(day)
+		target := int(schedule.QuotaTarget.Int32)
+		start, end := h.QuotaPeriodBounds(day)
+		day = end.AddDate(0, 0, 1)
+		// Периоды без квоты и периоды с паузой, в которых квота не выполнена, не учитываются
+		if target < 1 || h.isPausedBetween(start, end) && countLogged(logged, start, end) < target {
+			continue
+		}
+		expected += target
+		done += min(countLogged(logged, start, end), target)
+	}
+
+	if expected == 0 {
+		return 0
+	}
+	return float64(done) / float64(expected) * 100
+}
+
+// countLogged считает дни с логом в интервале [from, to]
+func countLogged(logged map[time.Time]bool, from, to time.Time) int {
+	count := 0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if logged[day] {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func quotaHabit(target int, period QuotaPeriod) *Habit {
+	h := dailyHabit()
+	h.Frequency = FrequencyQuota
+	_ = h.SetQuota(target, period)
+	return h
+}
+
+func TestPeriodBounds(t *testing.T) {
+	tests := []struct {
+		name      string
+		period    QuotaPeriod
+		date      string
+		wantStart string
+		wantEnd   string
+	}{
+		{"week from monday", QuotaPeriodWeek, "2026-01-05", "2026-01-05", "2026-01-11"},
+		{"week from sunday", QuotaPeriodWeek, "2026-01-04", "2025-12-29", "2026-01-04"},
+		{"month", QuotaPeriodMonth, "2026-02-14", "2026-02-01", "2026-02-28"},
+		{"leap month", QuotaPeriodMonth, "2028-02-29", "2028-02-01", "2028-02-29"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := PeriodBounds(tt.period, date(tt.date))
+			if !start.Equal(date(tt.wantStart)) || !end.Equal(date(tt.wantEnd)) {
+				t.Errorf("PeriodBounds = %v..%v, want %s..%s", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestQuotaCompletionRate(t *testing.T) {
+	tests := []struct {
+		name   string
+		habit  func() *Habit
+		logged []time.Time
+		from   string
+		to     string
+		want   float64
+	}{
+		{
+			name:   "partially met weeks",
+			habit:  func() *Habit { return quotaHabit(3, QuotaPeriodWeek) },
+			logged: dates("2026-01-05", "2026-01-06", "2026-01-07", "2026-01-12"),
+			from:   "2026-01-05",
+			to:     "2026-01-18",
+			want:   100 * 4.0 / 6,
+		},
+		{
+			name:   "overfulfilment is not carried to other weeks",
+			habit:  func() *Habit { return quotaHabit(3, QuotaPeriodWeek) },
+			logged: dates("2026-01-05", "2026-01-06", "2026-01-07", "2026-01-08", "2026-01-09"),
+			from:   "2026-01-05",
+			to:     "2026-01-18",
+			want:   50,
+		},
+		{
+			name:   "logs outside the interval are ignored",
+			habit:  func() *Habit { return quotaHabit(2, QuotaPeriodWeek) },
+			logged: dates("2026-01-04", "2026-01-05", "2026-01-19"),
+			from:   "2026-01-05",
+			to:     "2026-01-11",
+			want:   50,
+		},
+		{
+			name: "paused week with unmet quota is excluded",
+			habit: func() *Habit {
+				h := quotaHabit(3, QuotaPeriodWeek)
+				pause, _ := NewPause(h.UserID, h.ID, date("2026-01-14"), date("2026-01-15"), "")
+				h.SetPauses([]*Pause{pause})
+				return h
+			},
+			logged: dates("2026-01-05", "2026-01-06", "2026-01-07", "2026-01-12"),
+			from:   "2026-01-05",
+			to:     "2026-01-18",
+			want:   100,
+		},
+		{
+			name: "paused week with met quota still counts",
+			habit: func() *Habit {
+				h := quotaHabit(2, QuotaPeriodWeek)
+				pause, _ := NewPause(h.UserID, h.ID, date("2026-01-14"), date("2026-01-15"), "")
+				h.SetPauses([]*Pause{pause})
+				return h
+			},
+			logged: dates("2026-01-05", "2026-01-12", "2026-01-13"),
+			from:   "2026-01-05",
+			to:     "2026-01-18",
+			want:   75,
+		},
+		{
+			name:   "monthly quota",
+			habit:  func() *Habit { return quotaHabit(4, QuotaPeriodMonth) },
+			logged: dates("2026-01-02", "2026-01-20"),
+			from:   "2026-01-01",
+			to:     "2026-01-31",
+			want:   50,
+		},
+		{
+			name:  "no quota",
+			habit: dailyHabit,
+			from:  "2026-01-01",
+			to:    "2026-01-31",
+			want:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.habit().QuotaCompletionRate(tt.logged, date(tt.from), date(tt.to))
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("QuotaCompletionRate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsQuotaMet(t *testing.T) {
+	h := quotaHabit(2, QuotaPeriodWeek)
+	logged := map[time.Time]bool{date("2026-01-05"): true, date("2026-01-11"): true, date("2026-01-12"): true}
+
+	if !h.IsQuotaMet(date("2026-01-07"), logged) {
+		t.Error("week of 2026-01-05 should be met")
+	}
+	if h.IsQuotaMet(date("2026-01-14"), logged) {
+		t.Error("week of 2026-01-12 should not be met")
+	}
+}
@@ -0,0 +1,425 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRRule возвращается при некорректном или неподдерживаемом правиле RRULE
+var ErrInvalidRRule = errors.New("invalid rrule")
+
+// RRuleFreq частота правила повторения RFC 5545
+type RRuleFreq string
+
+const (
+	RRuleDaily   RRuleFreq = "DAILY"
+	RRuleWeekly  RRuleFreq = "WEEKLY"
+	RRuleMonthly RRuleFreq = "MONTHLY"
+)
+
+// maxRRuleCount ограничивает COUNT, чтобы развертка правила оставалась дешевой
+const maxRRuleCount = 10000
+
+// RRuleDay элемент BYDAY: день недели с необязательным порядковым номером в месяце (2TU, -1FR)
+type RRuleDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// RRule правило повторения привычки в формате iCalendar (RFC 5545).
+// Поддерживаются FREQ=DAILY/WEEKLY/MONTHLY, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL;
+// работа идет с датами без времени, неделя начинается с понедельника (WKST=MO).
+type RRule struct {
+	Freq       RRuleFreq
+	Interval   int
+	ByDay      []RRuleDay
+	ByMonthDay []int
+	BySetPos   []int
+	Count      int
+	Until      time.Time
+}
+
+// rruleWeekdays коды дней недели RFC 5545
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ParseRRule разбирает правило вида "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", префикс "RRULE:" допускается
+func ParseRRule(s string) (*RRule, error) {
+	s = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(s)), "RRULE:")
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty", ErrInvalidRRule)
+	}
+
+	rule := &RRule{Interval: 1}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRRule, part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%w: duplicate %s", ErrInvalidRRule, key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.Freq = RRuleFreq(value)
+		case "INTERVAL":
+			rule.Interval, err = parseRRuleInt(value, 1, 1000)
+		case "COUNT":
+			rule.Count, err = parseRRuleInt(value, 1, maxRRuleCount)
+		case "UNTIL":
+			rule.Until, err = parseRRuleDate(value)
+		case "BYDAY":
+			rule.ByDay, err = parseRRuleDays(value)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRRuleInts(value, 31)
+		case "BYSETPOS":
+			rule.BySetPos, err = parseRRuleInts(value, 366)
+		case "WKST":
+			if value != "MO" {
+				err = errors.New("only WKST=MO is supported")
+			}
+		default:
+			err = errors.New("unsupported part")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidRRule, key, err)
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRRule, err)
+	}
+	return rule, nil
+}
+
+// validate проверяет сочетания частей правила
+func (r *RRule) validate() error {
+	switch r.Freq {
+	case RRuleDaily, RRuleWeekly, RRuleMonthly:
+	case "":
+		return errors.New("FREQ is required")
+	default:
+		return fmt.Errorf("unsupported FREQ %s", r.Freq)
+	}
+
+	if r.Count > 0 && !r.Until.IsZero() {
+		return errors.New("COUNT and UNTIL are mutually exclusive")
+	}
+	if r.Freq == RRuleWeekly && len(r.ByMonthDay) > 0 {
+		return errors.New("BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	}
+	if r.Freq != RRuleMonthly {
+		for _, d := range r.ByDay {
+			if d.Ordinal != 0 {
+				return errors.New("numeric BYDAY is allowed only with FREQ=MONTHLY")
+			}
+		}
+	}
+	if len(r.BySetPos) > 0 && len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+		return errors.New("BYSETPOS requires BYDAY or BYMONTHDAY")
+	}
+	return nil
+}
+
+// String возвращает правило в каноническом виде для хранения
+func (r *RRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.BySetPos))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// String возвращает элемент BYDAY в формате RFC 5545
+func (d RRuleDay) String() string {
+	code := ""
+	for c, wd := range rruleWeekdays {
+		if wd == d.Weekday {
+			code = c
+		}
+	}
+	if d.Ordinal == 0 {
+		return code
+	}
+	return strconv.Itoa(d.Ordinal) + code
+}
+
+// OccursOn проверяет, приходится ли на дату повторение правила, начатого в dtstart
+func (r *RRule) OccursOn(dtstart, date time.Time) bool {
+	return len(r.Between(dtstart, date, date)) > 0
+}
+
+// Between разворачивает правило с началом dtstart и возвращает повторения в интервале [from, to]
+func (r *RRule) Between(dtstart, from, to time.Time) []time.Time {
+	dtstart, from, to = DateOf(dtstart), DateOf(from), DateOf(to)
+	if from.Before(dtstart) {
+		from = dtstart
+	}
+	if !r.Until.IsZero() && to.After(r.Until) {
+		to = r.Until
+	}
+	if from.After(to) {
+		return nil
+	}
+
+	// Без COUNT можно начинать сразу с периода, содержащего from;
+	// с COUNT повторения нумеруются от dtstart, поэтому развертка идет с начала
+	period := 0
+	if r.Count == 0 {
+		period = r.periodIndex(dtstart, from)
+		period -= period % r.Interval
+	}
+
+	var occurrences []time.Time
+	emitted := 0
+	for ; ; period += r.Interval {
+		start := r.periodStart(dtstart, period)
+		if start.After(to) {
+			break
+		}
+
+		for _, day := range r.expandPeriod(dtstart, start) {
+			if day.Before(dtstart) {
+				continue
+			}
+			emitted++
+			if r.Count > 0 && emitted > r.Count {
+				return occurrences
+			}
+			if !day.Before(from) && !day.After(to) {
+				occurrences = append(occurrences, day)
+			}
+		}
+	}
+	return occurrences
+}
+
+// periodIndex возвращает номер периода правила, содержащего date, считая период dtstart нулевым
+func (r *RRule) periodIndex(dtstart, date time.Time) int {
+	switch r.Freq {
+	case RRuleWeekly:
+		return DaysBetween(weekStart(dtstart), weekStart(date)) / 7
+	case RRuleMonthly:
+		return (date.Year()-dtstart.Year())*12 + int(date.Month()) - int(dtstart.Month())
+	default:
+		return DaysBetween(dtstart, date)
+	}
+}
+
+// periodStart возвращает первый день периода с номером n
+func (r *RRule) periodStart(dtstart time.Time, n int) time.Time {
+	switch r.Freq {
+	case RRuleWeekly:
+		return weekStart(dtstart).AddDate(0, 0, 7*n)
+	case RRuleMonthly:
+		return time.Date(dtstart.Year(), dtstart.Month()+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return dtstart.AddDate(0, 0, n)
+	}
+}
+
+// expandPeriod возвращает отсортированные повторения внутри периода, начинающегося в start
+func (r *RRule) expandPeriod(dtstart, start time.Time) []time.Time {
+	var candidates []time.Time
+
+	switch r.Freq {
+	case RRuleDaily:
+		if r.matchesByDay(start) && r.matchesByMonthDay(start) {
+			candidates = append(candidates, start)
+		}
+
+	case RRuleWeekly:
+		for i := 0; i < 7; i++ {
+			day := start.AddDate(0, 0, i)
+			if len(r.ByDay) > 0 && r.matchesByDay(day) || len(r.ByDay) == 0 && day.Weekday() == dtstart.Weekday() {
+				candidates = append(candidates, day)
+			}
+		}
+
+	case RRuleMonthly:
+		for day := start; day.Month() == start.Month(); day = day.AddDate(0, 0, 1) {
+			var ok bool
+			if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+				ok = day.Day() == dtstart.Day()
+			} else {
+				ok = r.matchesByDay(day) && r.matchesByMonthDay(day)
+			}
+			if ok {
+				candidates = append(candidates, day)
+			}
+		}
+	}
+
+	if len(r.BySetPos) == 0 {
+		return candidates
+	}
+	return applySetPos(candidates, r.BySetPos)
+}
+
+// matchesByDay проверяет фильтр BYDAY; пустой BYDAY пропускает любой день
+func (r *RRule) matchesByDay(day time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, d := range r.ByDay {
+		if d.Weekday != day.Weekday() {
+			continue
+		}
+		switch {
+		case d.Ordinal == 0:
+			return true
+		case d.Ordinal > 0 && (day.Day()-1)/7+1 == d.Ordinal:
+			return true
+		case d.Ordinal < 0 && (daysInMonth(day)-day.Day())/7+1 == -d.Ordinal:
+			return true
+		}
+	}
+	return false
+}
+
+// matchesByMonthDay проверяет фильтр BYMONTHDAY; отрицательные дни считаются от конца месяца
+func (r *RRule) matchesByMonthDay(day time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	last := daysInMonth(day)
+	for _, d := range r.ByMonthDay {
+		if d == day.Day() || d < 0 && last+d+1 == day.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// applySetPos оставляет из набора повторений периода только позиции BYSETPOS
+func applySetPos(candidates []time.Time, positions []int) []time.Time {
+	var result []time.Time
+	for _, pos := range positions {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(candidates) + pos
+		}
+		if idx >= 0 && idx < len(candidates) {
+			result = append(result, candidates[idx])
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+
+	// Разные позиции могут указывать на один день
+	unique := result[:0]
+	for i, day := range result {
+		if i == 0 || !day.Equal(result[i-1]) {
+			unique = append(unique, day)
+		}
+	}
+	return unique
+}
+
+// weekStart возвращает понедельник недели, содержащей date
+func weekStart(date time.Time) time.Time {
+	return date.AddDate(0, 0, 1-WeekdayNumber(date.Weekday()))
+}
+
+// parseRRuleInt разбирает целое число в диапазоне [lo, hi]
+func parseRRuleInt(s string, lo, hi int) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < lo || n > hi {
+		return 0, fmt.Errorf("%q must be an integer in range %d-%d", s, lo, hi)
+	}
+	return n, nil
+}
+
+// parseRRuleInts разбирает список ненулевых чисел в диапазоне [-limit, limit]
+func parseRRuleInts(s string, limit int) ([]int, error) {
+	var result []int
+	for _, part := range strings.Split(s, ",") {
+		n, err := parseRRuleInt(strings.TrimPrefix(part, "+"), -limit, limit)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, errors.New("0 is not allowed")
+		}
+		result = append(result, n)
+	}
+	return result, nil
+}
+
+// parseRRuleDays разбирает BYDAY: "MO,WE", "2TU", "-1FR"
+func parseRRuleDays(s string) ([]RRuleDay, error) {
+	var result []RRuleDay
+	for _, part := range strings.Split(s, ",") {
+		if len(part) < 2 {
+			return nil, fmt.Errorf("invalid day %q", part)
+		}
+		weekday, ok := rruleWeekdays[part[len(part)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q", part)
+		}
+
+		day := RRuleDay{Weekday: weekday}
+		if prefix := strings.TrimPrefix(part[:len(part)-2], "+"); prefix != "" {
+			n, err := parseRRuleInt(prefix, -5, 5)
+			if err != nil || n == 0 {
+				return nil, fmt.Errorf("invalid day %q", part)
+			}
+			day.Ordinal = n
+		}
+		result = append(result, day)
+	}
+	return result, nil
+}
+
+// parseRRuleDate разбирает UNTIL в формате DATE или DATE-TIME, время отбрасывается
+func parseRRuleDate(s string) (time.Time, error) {
+	date, _, _ := strings.Cut(s, "T")
+	t, err := time.Parse("20060102", date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a date", s)
+	}
+	return t, nil
+}
+
+// joinInts собирает числа через запятую
+func joinInts(nums []int) string {
+	parts := make([]string, len(nums))
+	for i, n := range nums {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{in: "RRULE:freq=weekly;interval=2;byday=mo,we", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{in: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", want: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{in: "FREQ=MONTHLY;BYMONTHDAY=-1", want: "FREQ=MONTHLY;BYMONTHDAY=-1"},
+		{in: "FREQ=MONTHLY;BYDAY=+2TU", want: "FREQ=MONTHLY;BYDAY=2TU"},
+		{in: "FREQ=DAILY;COUNT=10", want: "FREQ=DAILY;COUNT=10"},
+		{in: "FREQ=DAILY;UNTIL=20260131T235959Z", want: "FREQ=DAILY;UNTIL=20260131"},
+		{in: "FREQ=WEEKLY;WKST=MO", want: "FREQ=WEEKLY"},
+		{in: "", wantErr: true},
+		{in: "INTERVAL=2", wantErr: true},
+		{in: "FREQ=YEARLY", wantErr: true},
+		{in: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+		{in: "FREQ=DAILY;COUNT=0", wantErr: true},
+		{in: "FREQ=DAILY;COUNT=10001", wantErr: true},
+		{in: "FREQ=DAILY;COUNT=5;UNTIL=20260131", wantErr: true},
+		{in: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{in: "FREQ=WEEKLY;BYDAY=2TU", wantErr: true},
+		{in: "FREQ=MONTHLY;BYSETPOS=1", wantErr: true},
+		{in: "FREQ=MONTHLY;BYMONTHDAY=0", wantErr: true},
+		{in: "FREQ=MONTHLY;BYDAY=6MO", wantErr: true},
+		{in: "FREQ=WEEKLY;WKST=SU", wantErr: true},
+		{in: "FREQ=DAILY;BYHOUR=9", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			rule, err := ParseRRule(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRRule) {
+					t.Fatalf("ParseRRule(%q) error = %v, want ErrInvalidRRule", tt.in, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRRule(%q) error = %v", tt.in, err)
+			}
+			if got := rule.String(); got != tt.want {
+				t.Errorf("ParseRRule(%q).String() = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRuleBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		dtstart string
+		from    string
+		to      string
+		want    []time.Time
+	}{
+		{
+			name:    "last day of month",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart: "2026-01-01",
+			from:    "2026-01-01",
+			to:      "2026-04-30",
+			want:    dates("2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"),
+		},
+		{
+			name:    "last day of leap february",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart: "2028-02-01",
+			from:    "2028-02-01",
+			to:      "2028-02-29",
+			want:    dates("2028-02-29"),
+		},
+		{
+			name:    "last weekday of month",
+			rule:    "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			dtstart: "2026-01-01",
+			from:    "2026-01-01",
+			to:      "2026-03-31",
+			want:    dates("2026-01-30", "2026-02-27", "2026-03-31"),
+		},
+		{
+			name:    "first and last monday",
+			rule:    "FREQ=MONTHLY;BYDAY=MO;BYSETPOS=1,-1",
+			dtstart: "2026-01-01",
+			from:    "2026-01-01",
+			to:      "2026-01-31",
+			want:    dates("2026-01-05", "2026-01-26"),
+		},
+		{
+			name:    "second tuesday",
+			rule:    "FREQ=MONTHLY;BYDAY=2TU",
+			dtstart: "2026-01-01",
+			from:    "2026-01-01",
+			to:      "2026-02-28",
+			want:    dates("2026-01-13", "2026-02-10"),
+		},
+		{
+			name:    "last friday",
+			rule:    "FREQ=MONTHLY;BYDAY=-1FR",
+			dtstart: "2026-01-01",
+			from:    "2026-01-01",
+			to:      "2026-02-28",
+			want:    dates("2026-01-30", "2026-02-27"),
+		},
+		{
+			name:    "monthly on dtstart day skips short months",
+			rule:    "FREQ=MONTHLY",
+			dtstart: "2026-01-31",
+			from:    "2026-01-01",
+			to:      "2026-03-31",
+			want:    dates("2026-01-31", "2026-03-31"),
+		},
+		{
+			name:    "count cutoff",
+			rule:    "FREQ=DAILY;COUNT=3",
+			dtstart: "2026-01-01",
+			from:    "2026-01-01",
+			to:      "2026-01-10",
+			want:    dates("2026-01-01", "2026-01-02", "2026-01-03"),
+		},
+		{
+			name:    "count is numbered from dtstart",
+			rule:    "FREQ=DAILY;COUNT=3",
+			dtstart: "2026-01-01",
+			from:    "2026-01-03",
+			to:      "2026-01-10",
+			want:    dates("2026-01-03"),
+		},
+		{
+			name:    "count does not include days before dtstart",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=3",
+			dtstart: "2026-01-01",
+			from:    "2025-12-01",
+			to:      "2026-01-31",
+			want:    dates("2026-01-05", "2026-01-07", "2026-01-12"),
+		},
+		{
+			name:    "until is inclusive",
+			rule:    "FREQ=DAILY;INTERVAL=2;UNTIL=20260107",
+			dtstart: "2026-01-01",
+			from:    "2026-01-01",
+			to:      "2026-01-31",
+			want:    dates("2026-01-01", "2026-01-03", "2026-01-05", "2026-01-07"),
+		},
+		{
+			name:    "biweekly from the middle of the range",
+			rule:    "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			dtstart: "2026-01-05",
+			from:    "2026-01-10",
+			to:      "2026-02-05",
+			want:    dates("2026-01-19", "2026-02-02"),
+		},
+		{
+			name:    "weekly without byday repeats dtstart weekday",
+			rule:    "FREQ=WEEKLY",
+			dtstart: "2026-01-01",
+			from:    "2026-01-01",
+			to:      "2026-01-20",
+			want:    dates("2026-01-01", "2026-01-08", "2026-01-15"),
+		},
+		{
+			name:    "range before dtstart",
+			rule:    "FREQ=DAILY",
+			dtstart: "2026-02-01",
+			from:    "2026-01-01",
+			to:      "2026-01-31",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := rule.Between(date(tt.dtstart), date(tt.from), date(tt.to))
+			if len(got) != len(tt.want) {
+				t.Fatalf("Between = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("Between[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRRuleOccursOn(t *testing.T) {
+	rule, err := ParseRRule("FREQ=WEEKLY;BYDAY=MO,WE;COUNT=3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2026-01-05", true},
+		{"2026-01-06", false},
+		{"2026-01-12", true},
+		// Четвертое повторение отрезано COUNT
+		{"2026-01-14", false},
+	}
+	for _, tt := range tests {
+		if got := rule.OccursOn(date("2026-01-01"), date(tt.date)); got != tt.want {
+			t.Errorf("OccursOn(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestHabitRRuleSchedule(t *testing.T) {
+	h := dailyHabit()
+	rule, _ := ParseRRule("FREQ=DAILY;COUNT=3")
+	h.SetRRule(rule, date("2026-01-01"))
+
+	// Дни после окончания COUNT не запланированы и стрик не обрывают
+	state := ComputeStreak(h, dates("2026-01-01", "2026-01-02", "2026-01-03"), date("2026-01-10"))
+	if state.CurrentStreak != 3 {
+		t.Errorf("CurrentStreak = %d, want 3", state.CurrentStreak)
+	}
+
+	// Смена правила сбрасывает разобранное ранее
+	if !h.IsScheduledOn(date("2026-01-02")) {
+		t.Fatal("2026-01-02 should be scheduled")
+	}
+	rule, _ = ParseRRule("FREQ=WEEKLY;BYDAY=MO")
+	h.SetRRule(rule, date("2026-01-01"))
+	if h.IsScheduledOn(date("2026-01-02")) || !h.IsScheduledOn(date("2026-01-05")) {
+		t.Error("schedule should follow the new rule")
+	}
+}
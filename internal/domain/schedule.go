@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IsScheduledOn проверяет, запланирована ли привычка на дату по расписанию, действовавшему в этот день
+func (h *Habit) IsScheduledOn(date time.Time) bool {
+	// От привычки-отказа отказываются, выполнять ее не нужно; вне окна челленджа привычка не планируется
+	if h.IsPausedOn(date) || h.IsQuit() || !h.IsWithinWindow(date) {
+		return false
+	}
+
+	schedule, _ := h.scheduleOn(date)
+	return schedule.matchesSchedule(date)
+}
+
+// matchesSchedule проверяет дату по расписанию привычки без учета пауз и редакций
+func (h *Habit) matchesSchedule(date time.Time) bool {
+	if h.RRule.Valid {
+		// Правило валидируется при сохранении, испорченное значение означает "не запланировано"
+		rule, err := h.parsedRRule()
+		if err != nil {
+			return false
+		}
+		return rule.OccursOn(h.RRuleStart.Time, date)
+	}
+
+	switch h.Frequency {
+	case FrequencyDaily:
+		return true
+
+	case FrequencyWeekly:
+		if !h.WeeklyDays.Valid {
+			return false
+		}
+		return containsDay(h.WeeklyDays.String, WeekdayNumber(date.Weekday()))
+
+	case FrequencyMonthly:
+		if !h.MonthlyDays.Valid {
+			return false
+		}
+		// Некорректные правила пропускаются: валидация выполняется при сохранении
+		for _, part := range strings.Split(h.MonthlyDays.String, ",") {
+			if rule, err := ParseMonthlyRule(part); err == nil && rule.Matches(date) {
+				return true
+			}
+		}
+		return false
+
+	case FrequencyInterval:
+		if !h.IntervalDays.Valid || !h.AnchorDate.Valid {
+			return false
+		}
+		days := DaysBetween(h.AnchorDate.Time, date)
+		return days >= 0 && days%int(h.IntervalDays.Int32) == 0
+
+	case FrequencyQuota:
+		// Квоту можно выполнять в любой день периода
+		return h.QuotaTarget.Valid && h.QuotaPeriod.Valid
+
+	default:
+		return false
+	}
+}
+
+// ScheduledDaysBetween возвращает запланированные дни привычки в интервале [from, to];
+// каждый отрезок интервала оценивается по редакции расписания, действовавшей на нем.
+// Дни вне окна челленджа не планируются.
+func (h *Habit) ScheduledDaysBetween(from, to time.Time) []time.Time {
+	if h.IsQuit() {
+		return nil
+	}
+	from, to = h.clampToWindow(from, to)
+
+	var scheduledDays []time.Time
+	for start := from; !start.After(to); {
+		schedule, next := h.scheduleOn(start)
+		end := to
+		if !next.IsZero() && next.AddDate(0, 0, -1).Before(end) {
+			end = next.AddDate(0, 0, -1)
+		}
+
+		for _, day := range schedule.matchingDaysBetween(start, end) {
+			if !h.IsPausedOn(day) {
+				scheduledDays = append(scheduledDays, day)
+			}
+		}
+		start = end.AddDate(0, 0, 1)
+	}
+	return scheduledDays
+}
+
+// matchingDaysBetween возвращает дни интервала [from, to], подходящие под расписание, без учета пауз и редакций
+func (h *Habit) matchingDaysBetween(from, to time.Time) []time.Time {
+	if h.RRule.Valid {
+		rule, err := h.parsedRRule()
+		if err != nil {
+			return nil
+		}
+		return rule.Between(h.RRuleStart.Time, from, to)
+	}
+
+	var days []time.Time
+	for current := from; !current.After(to); current = current.AddDate(0, 0, 1) {
+		if h.matchesSchedule(current) {
+			days = append(days, current)
+		}
+	}
+	return days
+}
+
+// parsedRRule возвращает разобранное правило привычки; правило разбирается один раз, пока RRule не изменится
+func (h *Habit) parsedRRule() (*RRule, error) {
+	if h.rule == nil || h.ruleSource != h.RRule.String {
+		rule, err := ParseRRule(h.RRule.String)
+		if err != nil {
+			return nil, err
+		}
+		h.rule, h.ruleSource = rule, h.RRule.String
+	}
+	return h.rule, nil
+}
+
+// WeekdayNumber преобразует Go weekday (0=Sunday) в номер дня недели (1=Monday, 7=Sunday)
+func WeekdayNumber(wd time.Weekday) int {
+	if wd == time.Sunday {
+		return 7
+	}
+	return int(wd)
+}
+
+// containsDay проверяет, содержит ли строка дней "1,3,5" определенный день
+func containsDay(daysStr string, day int) bool {
+	for _, part := range strings.Split(daysStr, ",") {
+		if strings.TrimSpace(part) == strconv.Itoa(day) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"database/sql"
+	"slices"
+	"time"
+)
+
+// ScheduleRevision редакция расписания привычки, действующая с EffectiveFrom до следующей редакции.
+// Прошлые дни оцениваются по редакции, действовавшей в тот день, поэтому смена расписания не переписывает историю.
+type ScheduleRevision struct {
+	ID            int            `db:"id"`
+	HabitID       int            `db:"habit_id"`
+	EffectiveFrom time.Time      `db:"effective_from"`
+	Frequency     HabitFrequency `db:"frequency"`
+	WeeklyDays    sql.NullString `db:"weekly_days"`
+	MonthlyDays   sql.NullString `db:"monthly_days"`
+	IntervalDays  sql.NullInt32  `db:"interval_days"`
+	AnchorDate    sql.NullTime   `db:"anchor_date"`
+	QuotaTarget   sql.NullInt32  `db:"quota_target"`
+	QuotaPeriod   sql.NullString `db:"quota_period"`
+	RRule         sql.NullString `db:"rrule"`
+	RRuleStart    sql.NullTime   `db:"rrule_start"`
+	CreatedAt     time.Time      `db:"created_at"`
+
+	// schedule привычка с расписанием редакции, собирается при первом обращении
+	schedule *Habit
+}
+
+// NewScheduleRevision фиксирует текущее расписание привычки как действующее с effectiveFrom
+func NewScheduleRevision(h *Habit, effectiveFrom time.Time) *ScheduleRevision {
+	return &ScheduleRevision{
+		HabitID:       h.ID,
+		EffectiveFrom: DateOf(effectiveFrom),
+		Frequency:     h.Frequency,
+		WeeklyDays:    h.WeeklyDays,
+		MonthlyDays:   h.MonthlyDays,
+		IntervalDays:  h.IntervalDays,
+		AnchorDate:    h.AnchorDate,
+		QuotaTarget:   h.QuotaTarget,
+		QuotaPeriod:   h.QuotaPeriod,
+		RRule:         h.RRule,
+		RRuleStart:    h.RRuleStart,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// SetScheduleRevisions передает привычке редакции ее расписания, чужие редакции отбрасываются
+func (h *Habit) SetScheduleRevisions(revisions []*ScheduleRevision) {
+	h.revisions = nil
+	for _, r := range revisions {
+		if r.HabitID == h.ID {
+			h.revisions = append(h.revisions, r)
+		}
+	}
+	slices.SortFunc(h.revisions, func(a, b *ScheduleRevision) int {
+		return a.EffectiveFrom.Compare(b.EffectiveFrom)
+	})
+}
+
+// scheduleOn возвращает привычку с расписанием, действовавшим в день date, и первый день следующей редакции
+// (нулевой, если действует последняя). Дни до первой редакции оцениваются по ней. Последняя редакция
+// совпадает с текущим расписанием, поэтому для нее и для привычки без редакций возвращается сама привычка.
+func (h *Habit) scheduleOn(date time.Time) (*Habit, time.Time) {
+	date = DateOf(date)
+	i := 0
+	for i+1 < len(h.revisions) && !h.revisions[i+1].EffectiveFrom.After(date) {
+		i++
+	}
+	if i+1 >= len(h.revisions) {
+		return h, time.Time{}
+	}
+
+	r := h.revisions[i]
+	if r.schedule == nil {
+		// Копия переиспользуется, чтобы правило RRULE редакции разбиралось один раз
+		schedule := *h
+		schedule.Frequency = r.Frequency
+		schedule.WeeklyDays = r.WeeklyDays
+		schedule.MonthlyDays = r.MonthlyDays
+		schedule.IntervalDays = r.IntervalDays
+		schedule.AnchorDate = r.AnchorDate
+		schedule.QuotaTarget = r.QuotaTarget
+		schedule.QuotaPeriod = r.QuotaPeriod
+		schedule.RRule = r.RRule
+		schedule.RRuleStart = r.RRuleStart
+		schedule.rule, schedule.ruleSource = nil, ""
+		r.schedule = &schedule
+	}
+	return r.schedule, h.revisions[i+1].EffectiveFrom
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+// revisedHabit ежедневная с 1 января привычка, с 12 января перешедшая на понедельники
+func revisedHabit() *Habit {
+	h := dailyHabit()
+	daily := NewScheduleRevision(h, date("2026-01-01"))
+
+	h.Frequency = FrequencyWeekly
+	h.WeeklyDays = sql.NullString{String: "1", Valid: true}
+	weekly := NewScheduleRevision(h, date("2026-01-12"))
+
+	other := NewScheduleRevision(&Habit{ID: h.ID + 1, Frequency: FrequencyDaily}, date("2026-01-15"))
+	h.SetScheduleRevisions([]*ScheduleRevision{weekly, other, daily})
+	return h
+}
+
+func TestScheduleOn(t *testing.T) {
+	tests := []struct {
+		name          string
+		habit         func() *Habit
+		date          string
+		wantFrequency HabitFrequency
+		wantCurrent   bool
+		wantNext      string
+	}{
+		{
+			name:          "no revisions",
+			habit:         dailyHabit,
+			date:          "2026-01-05",
+			wantFrequency: FrequencyDaily,
+			wantCurrent:   true,
+		},
+		{
+			name: "single revision is the current schedule",
+			habit: func() *Habit {
+				h := dailyHabit()
+				h.SetScheduleRevisions([]*ScheduleRevision{NewScheduleRevision(h, date("2026-01-01"))})
+				return h
+			},
+			date:          "2026-01-05",
+			wantFrequency: FrequencyDaily,
+			wantCurrent:   true,
+		},
+		{
+			name:          "before first revision",
+			habit:         revisedHabit,
+			date:          "2025-12-30",
+			wantFrequency: FrequencyDaily,
+			wantNext:      "2026-01-12",
+		},
+		{
+			name:          "old revision",
+			habit:         revisedHabit,
+			date:          "2026-01-11",
+			wantFrequency: FrequencyDaily,
+			wantNext:      "2026-01-12",
+		},
+		{
+			name:          "effective from is inclusive",
+			habit:         revisedHabit,
+			date:          "2026-01-12",
+			wantFrequency: FrequencyWeekly,
+			wantCurrent:   true,
+		},
+		{
+			name:          "other habit revisions are ignored",
+			habit:         revisedHabit,
+			date:          "2026-01-20",
+			wantFrequency: FrequencyWeekly,
+			wantCurrent:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := tt.habit()
+			schedule, next := h.scheduleOn(date(tt.date))
+			if schedule.Frequency != tt.wantFrequency {
+				t.Errorf("Frequency = %v, want %v", schedule.Frequency, tt.wantFrequency)
+			}
+			if (schedule == h) != tt.wantCurrent {
+				t.Errorf("current schedule = %v, want %v", schedule == h, tt.wantCurrent)
+			}
+
+			var wantNext time.Time
+			if tt.wantNext != "" {
+				wantNext = date(tt.wantNext)
+			}
+			if !next.Equal(wantNext) {
+				t.Errorf("next = %v, want %v", next, wantNext)
+			}
+		})
+	}
+}
+
+func TestScheduleRevisionsKeepHistory(t *testing.T) {
+	h := revisedHabit()
+
+	tests := []struct {
+		date string
+		want bool
+	}{
+		// Вторник по ежедневной редакции
+		{"2026-01-06", true},
+		// Вторник по еженедельной редакции
+		{"2026-01-13", false},
+		{"2026-01-19", true},
+	}
+	for _, tt := range tests {
+		if got := h.IsScheduledOn(date(tt.date)); got != tt.want {
+			t.Errorf("IsScheduledOn(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+
+	got := h.ScheduledDaysBetween(date("2026-01-10"), date("2026-01-20"))
+	want := dates("2026-01-10", "2026-01-11", "2026-01-12", "2026-01-19")
+	if len(got) != len(want) {
+		t.Fatalf("ScheduledDaysBetween = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("ScheduledDaysBetween[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	// Ежедневные отметки до смены и понедельники после нее дают непрерывный стрик
+	logged := append(h.ScheduledDaysBetween(date("2026-01-01"), date("2026-01-11")), dates("2026-01-12", "2026-01-19")...)
+	state := ComputeStreak(h, logged, date("2026-01-20"))
+	if state.CurrentStreak != 13 {
+		t.Errorf("CurrentStreak = %d, want 13", state.CurrentStreak)
+	}
+}
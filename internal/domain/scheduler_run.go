@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// SchedulerRun хранит время последнего успешного запуска периодической задачи
+type SchedulerRun struct {
+	JobName       string    `db:"job_name"`
+	LastSuccessAt time.Time `db:"last_success_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+// NewSchedulerRun создает запись об успешном запуске задачи
+func NewSchedulerRun(jobName string, lastSuccessAt time.Time) *SchedulerRun {
+	return &SchedulerRun{
+		JobName:       jobName,
+		LastSuccessAt: lastSuccessAt,
+		UpdatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ErrSkipNotSupported возвращается при пропуске дня привычки, которая не планируется по дням
+var ErrSkipNotSupported = errors.New("skips are supported only for habits scheduled by days")
+
+// Skip уважительный пропуск запланированного дня ("день отдыха", "зал закрыт"):
+// день не обрывает стрик, но и не продлевает его
+type Skip struct {
+	ID        int            `db:"id"`
+	HabitID   int            `db:"habit_id"`
+	UserID    int            `db:"user_id"`
+	SkipDate  time.Time      `db:"skip_date"`
+	Reason    sql.NullString `db:"reason"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+// NewSkip создает пропуск дня date
+func NewSkip(habitID, userID int, date time.Time, reason string) *Skip {
+	return &Skip{
+		HabitID:   habitID,
+		UserID:    userID,
+		SkipDate:  DateOf(date),
+		Reason:    sql.NullString{String: reason, Valid: reason != ""},
+		CreatedAt: time.Now(),
+	}
+}
+
+// GetReason возвращает причину или пустую строку
+func (s *Skip) GetReason() string {
+	if s.Reason.Valid {
+		return s.Reason.String
+	}
+	return ""
+}
+
+// CanSkip проверяет, можно ли пропускать дни привычки: квота выполняется в любые дни периода,
+// а привычка-отказ не планируется
+func (h *Habit) CanSkip() bool {
+	return !h.IsQuota() && !h.IsQuit()
+}
+
+// SetSkippedDays запоминает дни с уважительным пропуском
+func (h *Habit) SetSkippedDays(skips []*Skip) {
+	h.skippedDays = make(map[time.Time]bool, len(skips))
+	for _, skip := range skips {
+		if skip.HabitID == h.ID {
+			h.skippedDays[DateOf(skip.SkipDate)] = true
+		}
+	}
+}
+
+// IsSkippedOn проверяет, пропущен ли день по уважительной причине
+func (h *Habit) IsSkippedOn(date time.Time) bool {
+	return h.skippedDays[DateOf(date)]
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"database/sql"
+	"time"
+)
+
+// StreakState стрик привычки, вычисленный по истории логов
+type StreakState struct {
+	CurrentStreak     int
+	BestStreak        int
+	LastCompletedDate sql.NullTime
+	// StreakStart первый день текущего стрика; нулевой, если стрика нет
+	StreakStart time.Time
+}
+
+// ComputeStreak вычисляет стрик привычки по ее расписанию и всей истории логов на дату today.
+// Каждый день с логом продлевает стрик, запланированный день без лога обрывает его.
+// Сегодняшний день без лога стрик не обрывает - его еще можно выполнить.
+func ComputeStreak(habit *Habit, loggedDates []time.Time, today time.Time) StreakState {
+	today = DateOf(today)
+
+	logged := make(map[time.Time]bool, len(loggedDates))
+	var first time.Time
+	for _, date := range loggedDates {
+		date = DateOf(date)
+		if date.After(today) {
+			continue
+		}
+		logged[date] = true
+		if first.IsZero() || date.Before(first) {
+			first = date
+		}
+	}
+
+	var state StreakState
+	if first.IsZero() {
+		return state
+	}
+
+	if habit.IsQuota() {
+		return computeQuotaStreak(habit, logged, first, today)
+	}
+
+	// Расписание разворачивается один раз на весь интервал, а не проверяется по дням
+	scheduled := make(map[time.Time]bool)
+	for _, day := range habit.ScheduledDaysBetween(first, today) {
+		scheduled[day] = true
+	}
+
+	run := 0
+	for day := first; !day.After(today); day = day.AddDate(0, 0, 1) {
+		switch {
+		case logged[day]:
+			if run == 0 {
+				state.StreakStart = day
+			}
+			run++
+			state.BestStreak = max(state.BestStreak, run)
+			state.LastCompletedDate = sql.NullTime{Time: day, Valid: true}
+		case day.Equal(today):
+		case habit.isFrozenOn(day), habit.IsSkippedOn(day):
+			// Замороженный и уважительный пропуски стрик не обрывают
+		case scheduled[day]:
+			run = 0
+		}
+	}
+
+	state.CurrentStreak = run
+	if run == 0 {
+		state.StreakStart = time.Time{}
+	}
+	return state
+}
+
+// computeQuotaStreak считает стрик квотной привычки в периодах: выполненный период продлевает стрик,
+// завершившийся невыполненный обрывает, текущий невыполненный не влияет.
+func computeQuotaStreak(habit *Habit, logged map[time.Time]bool, first, today time.Time) StreakState {
+	var state StreakState
+	for date := range logged {
+		if !state.LastCompletedDate.Valid || date.After(state.LastCompletedDate.Time) {
+			state.LastCompletedDate = sql.NullTime{Time: date, Valid: true}
+		}
+	}
+
+	run := 0
+	for day := first; !day.After(today); {
+		start, end := habit.QuotaPeriodBounds(day)
+		switch {
+		case habit.IsQuotaMet(day, logged):
+			if run == 0 {
+				state.StreakStart = start
+			}
+			run++
+			state.BestStreak = max(state.BestStreak, run)
+		case habit.isPausedBetween(start, end), habit.isFrozenOn(end):
+			// Период с паузой или замороженным пропуском стрик не обрывает
+		case end.Before(today):
+			run = 0
+		}
+		day = end.AddDate(0, 0, 1)
+	}
+
+	state.CurrentStreak = run
+	if run == 0 {
+		state.StreakStart = time.Time{}
+	}
+	return state
+}
+
+// MissedDays возвращает дни в интервале [from, to], которые обрывают стрик.
+// Для привычек по дням это запланированные дни без лога и без уважительного пропуска; для квотных - последние дни
+// периодов, в которых квота не выполнена. logged должен покрывать целые периоды квоты.
+func (h *Habit) MissedDays(from, to time.Time, logged map[time.Time]bool) []time.Time {
+	var missed []time.Time
+	if h.IsQuota() {
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			start, end := h.QuotaPeriodBounds(day)
+			if day.Equal(end) && !h.IsQuotaMet(day, logged) && !h.isPausedBetween(start, end) {
+				missed = append(missed, day)
+			}
+		}
+		return missed
+	}
+
+	for _, day := range h.ScheduledDaysBetween(from, to) {
+		if !logged[day] && !h.IsSkippedOn(day) {
+			missed = append(missed, day)
+		}
+	}
+	return missed
+}
+
+// ApplyStreak устанавливает вычисленный стрик
+func (h *Habit) ApplyStreak(state StreakState) {
+	h.CurrentStreak = state.CurrentStreak
+	h.BestStreak = state.BestStreak
+	h.LastCompletedDate = state.LastCompletedDate
+	h.UpdatedAt = time.Now()
+}
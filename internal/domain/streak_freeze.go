@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidFreezeAmount возвращается при выдаче неположительного числа заморозок
+var ErrInvalidFreezeAmount = errors.New("freeze amount must be positive")
+
+// StreakFreezeBalance баланс заморозок стрика пользователя.
+// Заморозка автоматически тратится вместо сброса стрика за пропущенный день.
+type StreakFreezeBalance struct {
+	UserID    int       `db:"user_id"`
+	Tokens    int       `db:"tokens"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// FreezeMilestone возвращает последний достигнутый рубеж стрика, за который начисляется заморозка,
+// или 0, если стрик короче every
+func FreezeMilestone(streak, every int) int {
+	if every < 1 {
+		return 0
+	}
+	return streak / every * every
+}
+
+// SetFrozenDays запоминает дни, пропуск в которые закрыт заморозкой
+func (h *Habit) SetFrozenDays(days []time.Time) {
+	h.frozenDays = make(map[time.Time]bool, len(days))
+	for _, day := range days {
+		h.frozenDays[DateOf(day)] = true
+	}
+}
+
+// isFrozenOn проверяет, закрыт ли пропуск в дату заморозкой
+func (h *Habit) isFrozenOn(date time.Time) bool {
+	return h.frozenDays[DateOf(date)]
+}
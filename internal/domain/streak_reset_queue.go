@@ -15,16 +15,25 @@ type StreakResetQueue struct {
 	ProcessedAt     sql.NullTime   `db:"processed_at"`
 	PreviousStreak  sql.NullInt64  `db:"previous_streak"`
 	CreatedAt       time.Time      `db:"created_at"`
+	Attempts        int            `db:"attempts"`
+	LastError       sql.NullString `db:"last_error"`
+	NextAttemptAt   time.Time      `db:"next_attempt_at"`
+	LockedUntil     sql.NullTime   `db:"locked_until"`
+	DeadLetteredAt  sql.NullTime   `db:"dead_lettered_at"`
+	// FrozenAt время, когда пропуск закрыт заморозкой вместо сброса стрика
+	FrozenAt        sql.NullTime   `db:"frozen_at"`
 }
 
 // NewStreakResetQueue создает новую запись в очередь на сброс
 func NewStreakResetQueue(habitID, userID int, resetDate time.Time) *StreakResetQueue {
+	now := time.Now()
 	return &StreakResetQueue{
-		HabitID:    habitID,
-		UserID:     userID,
-		ResetDate:  sql.NullTime{Time: resetDate, Valid: true},
-		Processed:  false,
-		CreatedAt:  time.Now(),
+		HabitID:       habitID,
+		UserID:        userID,
+		ResetDate:     sql.NullTime{Time: resetDate, Valid: true},
+		Processed:     false,
+		CreatedAt:     now,
+		NextAttemptAt: now,
 	}
 }
 
@@ -34,6 +43,44 @@ func (srq *StreakResetQueue) MarkAsProcessed(previousStreak int) {
 	now := time.Now()
 	srq.ProcessedAt = sql.NullTime{Time: now, Valid: true}
 	srq.PreviousStreak = sql.NullInt64{Int64: int64(previousStreak), Valid: true}
+	srq.LockedUntil = sql.NullTime{}
+}
+
+// MarkAsFailed фиксирует неудачную попытку обработки.
+// После maxAttempts попыток запись переводится в dead letter, иначе откладывается на backoff.
+func (srq *StreakResetQueue) MarkAsFailed(err error, maxAttempts int, backoff time.Duration) {
+	now := time.Now()
+	srq.LastError = sql.NullString{String: err.Error(), Valid: true}
+	srq.LockedUntil = sql.NullTime{}
+
+	if srq.Attempts >= maxAttempts {
+		srq.DeadLetteredAt = sql.NullTime{Time: now, Valid: true}
+		return
+	}
+	srq.NextAttemptAt = now.Add(backoff)
+}
+
+// IsDeadLettered проверяет, исчерпала ли запись все попытки обработки
+func (srq *StreakResetQueue) IsDeadLettered() bool {
+	return srq.DeadLetteredAt.Valid
+}
+
+// Requeue возвращает запись из dead letter в очередь с обнулением попыток
+func (srq *StreakResetQueue) Requeue() {
+	srq.Attempts = 0
+	srq.NextAttemptAt = time.Now()
+	srq.LockedUntil = sql.NullTime{}
+	srq.DeadLetteredAt = sql.NullTime{}
+}
+
+// MarkAsFrozen отмечает пропуск закрытым заморозкой
+func (srq *StreakResetQueue) MarkAsFrozen(frozenAt time.Time) {
+	srq.FrozenAt = sql.NullTime{Time: frozenAt, Valid: true}
+}
+
+// IsFrozen проверяет, закрыт ли пропуск заморозкой
+func (srq *StreakResetQueue) IsFrozen() bool {
+	return srq.FrozenAt.Valid
 }
 
 // GetResetDate возвращает дату сброса или нулевое время
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+// date парсит дату "2006-01-02" для тестов
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// dates парсит несколько дат "2006-01-02"
+func dates(ss ...string) []time.Time {
+	result := make([]time.Time, len(ss))
+	for i, s := range ss {
+		result[i] = date(s)
+	}
+	return result
+}
+
+func dailyHabit() *Habit {
+	return &Habit{ID: 1, UserID: 1, Frequency: FrequencyDaily, CreatedAt: date("2026-01-01")}
+}
+
+func TestComputeStreak(t *testing.T) {
+	tests := []struct {
+		name        string
+		habit       func() *Habit
+		logged      []time.Time
+		today       string
+		wantCurrent int
+		wantBest    int
+		wantStart   string
+	}{
+		{
+			name:  "no logs",
+			habit: dailyHabit,
+			today: "2026-01-05",
+		},
+		{
+			name:        "consecutive days through today",
+			habit:       dailyHabit,
+			logged:      dates("2026-01-03", "2026-01-04", "2026-01-05"),
+			today:       "2026-01-05",
+			wantCurrent: 3,
+			wantBest:    3,
+			wantStart:   "2026-01-03",
+		},
+		{
+			name:        "today without log does not break",
+			habit:       dailyHabit,
+			logged:      dates("2026-01-03", "2026-01-04"),
+			today:       "2026-01-05",
+			wantCurrent: 2,
+			wantBest:    2,
+			wantStart:   "2026-01-03",
+		},
+		{
+			name:        "missed scheduled day breaks",
+			habit:       dailyHabit,
+			logged:      dates("2026-01-01", "2026-01-02", "2026-01-03", "2026-01-05"),
+			today:       "2026-01-05",
+			wantCurrent: 1,
+			wantBest:    3,
+			wantStart:   "2026-01-05",
+		},
+		{
+			name:        "future logs are ignored",
+			habit:       dailyHabit,
+			logged:      dates("2026-01-04", "2026-01-05", "2026-01-06"),
+			today:       "2026-01-05",
+			wantCurrent: 2,
+			wantBest:    2,
+			wantStart:   "2026-01-04",
+		},
+		{
+			name: "unscheduled days do not break",
+			habit: func() *Habit {
+				h := dailyHabit()
+				h.Frequency = FrequencyWeekly
+				h.WeeklyDays = sql.NullString{String: "1,3,5", Valid: true}
+				return h
+			},
+			// Пн, Ср, Пт
+			logged:      dates("2026-01-05", "2026-01-07", "2026-01-09"),
+			today:       "2026-01-11",
+			wantCurrent: 3,
+			wantBest:    3,
+			wantStart:   "2026-01-05",
+		},
+		{
+			name: "skipped day keeps streak without extending it",
+			habit: func() *Habit {
+				h := dailyHabit()
+				h.SetSkippedDays([]*Skip{NewSkip(h.ID, h.UserID, date("2026-01-03"), "")})
+				return h
+			},
+			logged:      dates("2026-01-01", "2026-01-02", "2026-01-04"),
+			today:       "2026-01-04",
+			wantCurrent: 3,
+			wantBest:    3,
+			wantStart:   "2026-01-01",
+		},
+		{
+			name: "paused days keep streak",
+			habit: func() *Habit {
+				h := dailyHabit()
+				pause, _ := NewPause(h.UserID, h.ID, date("2026-01-03"), date("2026-01-05"), "")
+				h.SetPauses([]*Pause{pause})
+				return h
+			},
+			logged:      dates("2026-01-01", "2026-01-02", "2026-01-06"),
+			today:       "2026-01-06",
+			wantCurrent: 3,
+			wantBest:    3,
+			wantStart:   "2026-01-01",
+		},
+		{
+			name: "vacation of another user does not apply",
+			habit: func() *Habit {
+				h := dailyHabit()
+				pause, _ := NewPause(h.UserID+1, 0, date("2026-01-03"), date("2026-01-05"), "")
+				h.SetPauses([]*Pause{pause})
+				return h
+			},
+			logged:      dates("2026-01-01", "2026-01-02", "2026-01-06"),
+			today:       "2026-01-06",
+			wantCurrent: 1,
+			wantBest:    2,
+			wantStart:   "2026-01-06",
+		},
+		{
+			name: "frozen day keeps streak",
+			habit: func() *Habit {
+				h := dailyHabit()
+				h.SetFrozenDays(dates("2026-01-03"))
+				return h
+			},
+			logged:      dates("2026-01-01", "2026-01-02", "2026-01-04"),
+			today:       "2026-01-04",
+			wantCurrent: 3,
+			wantBest:    3,
+			wantStart:   "2026-01-01",
+		},
+		{
+			name: "skip, pause and freeze together",
+			habit: func() *Habit {
+				h := dailyHabit()
+				h.SetSkippedDays([]*Skip{NewSkip(h.ID, h.UserID, date("2026-01-02"), "")})
+				pause, _ := NewPause(h.UserID, 0, date("2026-01-04"), date("2026-01-04"), "")
+				h.SetPauses([]*Pause{pause})
+				h.SetFrozenDays(dates("2026-01-06"))
+				return h
+			},
+			logged:      dates("2026-01-01", "2026-01-03", "2026-01-05", "2026-01-07"),
+			today:       "2026-01-08",
+			wantCurrent: 4,
+			wantBest:    4,
+			wantStart:   "2026-01-01",
+		},
+		{
+			name: "quota weeks count as streak units",
+			habit: func() *Habit {
+				h := dailyHabit()
+				_ = h.SetQuota(2, QuotaPeriodWeek)
+				h.Frequency = FrequencyQuota
+				return h
+			},
+			// Недели с 5 и 12 января выполнены, текущая неделя с 19 января еще идет
+			logged:      dates("2026-01-05", "2026-01-07", "2026-01-12", "2026-01-16", "2026-01-19"),
+			today:       "2026-01-20",
+			wantCurrent: 2,
+			wantBest:    2,
+			wantStart:   "2026-01-05",
+		},
+		{
+			name: "missed quota week breaks",
+			habit: func() *Habit {
+				h := dailyHabit()
+				_ = h.SetQuota(2, QuotaPeriodWeek)
+				h.Frequency = FrequencyQuota
+				return h
+			},
+			logged:      dates("2026-01-05", "2026-01-07", "2026-01-12", "2026-01-19", "2026-01-20"),
+			today:       "2026-01-21",
+			wantCurrent: 1,
+			wantBest:    1,
+			wantStart:   "2026-01-19",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := ComputeStreak(tt.habit(), tt.logged, date(tt.today))
+			if state.CurrentStreak != tt.wantCurrent || state.BestStreak != tt.wantBest {
+				t.Errorf("streak = %d/%d, want %d/%d", state.CurrentStreak, state.BestStreak, tt.wantCurrent, tt.wantBest)
+			}
+
+			var wantStart time.Time
+			if tt.wantStart != "" {
+				wantStart = date(tt.wantStart)
+			}
+			if !state.StreakStart.Equal(wantStart) {
+				t.Errorf("StreakStart = %v, want %v", state.StreakStart, wantStart)
+			}
+		})
+	}
+}
+
+func TestMissedDays(t *testing.T) {
+	h := dailyHabit()
+	h.SetSkippedDays([]*Skip{NewSkip(h.ID, h.UserID, date("2026-01-03"), "")})
+	logged := map[time.Time]bool{date("2026-01-01"): true}
+
+	missed := h.MissedDays(date("2026-01-01"), date("2026-01-04"), logged)
+	want := dates("2026-01-02", "2026-01-04")
+	if len(missed) != len(want) {
+		t.Fatalf("MissedDays = %v, want %v", missed, want)
+	}
+	for i := range want {
+		if !missed[i].Equal(want[i]) {
+			t.Errorf("MissedDays[%d] = %v, want %v", i, missed[i], want[i])
+		}
+	}
+}
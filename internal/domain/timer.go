@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// UnitMinutes единица цели привычки, время на которую засекается таймером
+const UnitMinutes = "min"
+
+var (
+	// ErrNotDurationHabit возвращается при запуске таймера для привычки без цели в минутах
+	ErrNotDurationHabit = errors.New("habit has no daily target in minutes")
+	// ErrTimerAlreadyRunning возвращается при запуске второго таймера
+	ErrTimerAlreadyRunning = errors.New("timer is already running")
+	// ErrNoActiveTimer возвращается при остановке, когда таймер не запущен
+	ErrNoActiveTimer = errors.New("no active timer")
+)
+
+// TimerSession сессия таймера привычки; у запущенного таймера StoppedAt пуст
+type TimerSession struct {
+	ID        int          `db:"id"`
+	HabitID   int          `db:"habit_id"`
+	UserID    int          `db:"user_id"`
+	StartedAt time.Time    `db:"started_at"`
+	StoppedAt sql.NullTime `db:"stopped_at"`
+}
+
+// DayDuration время сессии, пришедшееся на один календарный день
+type DayDuration struct {
+	Date    time.Time
+	Minutes float64
+}
+
+// NewTimerSession запускает таймер привычки
+func NewTimerSession(habitID, userID int) *TimerSession {
+	return &TimerSession{
+		HabitID:   habitID,
+		UserID:    userID,
+		StartedAt: time.Now(),
+	}
+}
+
+// IsDurationTracked проверяет, засекается ли время привычки таймером: цель задана в минутах
+func (h *Habit) IsDurationTracked() bool {
+	return h.IsQuantitative() && h.Unit.String == UnitMinutes
+}
+
+// IsRunning проверяет, запущен ли таймер
+func (t *TimerSession) IsRunning() bool {
+	return !t.StoppedAt.Valid
+}
+
+// Elapsed возвращает длительность сессии; у запущенной - на момент now
+func (t *TimerSession) Elapsed(now time.Time) time.Duration {
+	if t.StoppedAt.Valid {
+		now = t.StoppedAt.Time
+	}
+	return max(now.Sub(t.StartedAt), 0)
+}
+
+// StopAt возвращает момент остановки в now, но не позже maxDuration после запуска:
+// забытый таймер не засчитывает больше maxDuration. maxDuration 0 - без ограничения.
+func (t *TimerSession) StopAt(now time.Time, maxDuration time.Duration) time.Time {
+	if maxDuration > 0 && now.Sub(t.StartedAt) > maxDuration {
+		return t.StartedAt.Add(maxDuration)
+	}
+	return now
+}
+
+// DayDurations делит остановленную сессию по дням пользователя user:
+// таймер, работавший через начало дня, засчитывается частями в оба дня
+func (t *TimerSession) DayDurations(user *User) []DayDuration {
+	if !t.StoppedAt.Valid {
+		return nil
+	}
+
+	var days []DayDuration
+	start, stop := t.StartedAt, t.StoppedAt.Time
+	for start.Before(stop) {
+		date := user.DateAt(start)
+		end := stop
+		if next := user.DayStart(date.AddDate(0, 0, 1)); next.Before(stop) {
+			end = next
+		}
+		days = append(days, DayDuration{Date: date, Minutes: end.Sub(start).Minutes()})
+		start = end
+	}
+	return days
+}
+
+// MinutesOn возвращает минуты сессий, пришедшиеся на день date пользователя user
+func MinutesOn(sessions []*TimerSession, date time.Time, user *User) float64 {
+	date = DateOf(date)
+	total := 0.0
+	for _, session := range sessions {
+		for _, day := range session.DayDurations(user) {
+			if day.Date.Equal(date) {
+				total += day.Minutes
+			}
+		}
+	}
+	return total
+}
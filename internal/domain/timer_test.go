@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+)
+
+// session создает остановленную сессию таймера между моментами RFC 3339
+func session(start, stop string) *TimerSession {
+	started, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		panic(err)
+	}
+	stopped, err := time.Parse(time.RFC3339, stop)
+	if err != nil {
+		panic(err)
+	}
+	return &TimerSession{HabitID: 1, UserID: 1, StartedAt: started, StoppedAt: sql.NullTime{Time: stopped, Valid: true}}
+}
+
+func TestDayDurations(t *testing.T) {
+	earlyBird := &User{Timezone: "UTC", DayStartHour: 4}
+	tokyo := &User{Timezone: "Asia/Tokyo"}
+
+	tests := []struct {
+		name    string
+		user    *User
+		session *TimerSession
+		want    []DayDuration
+	}{
+		{
+			name:    "running session",
+			user:    earlyBird,
+			session: &TimerSession{StartedAt: date("2026-01-05")},
+		},
+		{
+			name:    "within one day",
+			user:    earlyBird,
+			session: session("2026-01-05T10:00:00Z", "2026-01-05T10:30:00Z"),
+			want:    []DayDuration{{date("2026-01-05"), 30}},
+		},
+		{
+			name:    "before day start belongs to previous day",
+			user:    earlyBird,
+			session: session("2026-01-05T02:00:00Z", "2026-01-05T03:00:00Z"),
+			want:    []DayDuration{{date("2026-01-04"), 60}},
+		},
+		{
+			name:    "midnight is not a day boundary",
+			user:    earlyBird,
+			session: session("2026-01-04T23:00:00Z", "2026-01-05T01:00:00Z"),
+			want:    []DayDuration{{date("2026-01-04"), 120}},
+		},
+		{
+			name:    "split at day start",
+			user:    earlyBird,
+			session: session("2026-01-05T03:30:00Z", "2026-01-05T04:15:00Z"),
+			want:    []DayDuration{{date("2026-01-04"), 30}, {date("2026-01-05"), 15}},
+		},
+		{
+			name:    "several days",
+			user:    earlyBird,
+			session: session("2026-01-05T03:00:00Z", "2026-01-06T05:00:00Z"),
+			want:    []DayDuration{{date("2026-01-04"), 60}, {date("2026-01-05"), 1440}, {date("2026-01-06"), 60}},
+		},
+		{
+			name:    "split at local midnight",
+			user:    tokyo,
+			session: session("2026-01-05T14:30:00Z", "2026-01-05T15:10:00Z"),
+			want:    []DayDuration{{date("2026-01-05"), 30}, {date("2026-01-06"), 10}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.session.DayDurations(tt.user)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DayDurations = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if !got[i].Date.Equal(tt.want[i].Date) || math.Abs(got[i].Minutes-tt.want[i].Minutes) > 1e-9 {
+					t.Errorf("DayDurations[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMinutesOn(t *testing.T) {
+	user := &User{Timezone: "UTC", DayStartHour: 4}
+	sessions := []*TimerSession{
+		session("2026-01-05T03:30:00Z", "2026-01-05T04:15:00Z"),
+		session("2026-01-05T20:00:00Z", "2026-01-05T20:20:00Z"),
+		session("2026-01-06T03:00:00Z", "2026-01-06T03:30:00Z"),
+		{StartedAt: date("2026-01-05")},
+	}
+
+	tests := []struct {
+		date string
+		want float64
+	}{
+		{"2026-01-04", 30},
+		{"2026-01-05", 65},
+		{"2026-01-06", 0},
+	}
+	for _, tt := range tests {
+		if got := MinutesOn(sessions, date(tt.date), user); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("MinutesOn(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
@@ -1,17 +1,36 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// DefaultTimezone часовой пояс пользователя по умолчанию
+const DefaultTimezone = "UTC"
+
+// MaxLogGrace максимальное окно льготы после начала дня
+const MaxLogGrace = 12 * time.Hour
+
+var (
+	// ErrInvalidTimezone возвращается, если часовой пояс не является корректным IANA именем
+	ErrInvalidTimezone = errors.New("invalid timezone")
+	// ErrInvalidDayBoundary возвращается при некорректном часе начала дня или окне льготы
+	ErrInvalidDayBoundary = errors.New("day start hour must be 0-23 and log grace must be between 0 and 12 hours")
+)
 
 // User представляет пользователя приложения
 type User struct {
-	ID           int       `db:"id"`
-	TelegramID   int64     `db:"telegram_id"`
-	FirstName    string    `db:"first_name"`
-	LastName     string    `db:"last_name"`
-	Username     string    `db:"username"`
-	LanguageCode string    `db:"language_code"`
-	CreatedAt    time.Time `db:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at"`
+	ID              int       `db:"id"`
+	TelegramID      int64     `db:"telegram_id"`
+	FirstName       string    `db:"first_name"`
+	LastName        string    `db:"last_name"`
+	Username        string    `db:"username"`
+	LanguageCode    string    `db:"language_code"`
+	Timezone        string    `db:"timezone"`
+	DayStartHour    int       `db:"day_start_hour"`    // до этого часа время относится к предыдущему дню
+	LogGraceMinutes int       `db:"log_grace_minutes"` // окно после начала дня, когда выполнение засчитывается во вчера
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
 }
 
 // NewUser создает нового пользователя из данных Telegram
@@ -23,7 +42,80 @@ func NewUser(telegramID int64, firstName, lastName, username, languageCode strin
 		LastName:     lastName,
 		Username:     username,
 		LanguageCode: languageCode,
+		Timezone:     DefaultTimezone,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}
 }
+
+// SetTimezone устанавливает часовой пояс пользователя (IANA имя, например "Europe/Lisbon")
+func (u *User) SetTimezone(timezone string) error {
+	if timezone == "" {
+		return ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return ErrInvalidTimezone
+	}
+	u.Timezone = timezone
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// Location возвращает часовой пояс пользователя, при некорректном значении - UTC
+func (u *User) Location() *time.Location {
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// SetDayBoundary устанавливает час начала дня и окно льготы в минутах
+func (u *User) SetDayBoundary(startHour, graceMinutes int) error {
+	if startHour < 0 || startHour > 23 {
+		return ErrInvalidDayBoundary
+	}
+	if graceMinutes < 0 || time.Duration(graceMinutes)*time.Minute > MaxLogGrace {
+		return ErrInvalidDayBoundary
+	}
+	u.DayStartHour = startHour
+	u.LogGraceMinutes = graceMinutes
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// LogGrace возвращает окно льготы
+func (u *User) LogGrace() time.Duration {
+	return time.Duration(u.LogGraceMinutes) * time.Minute
+}
+
+// DateAt возвращает дату пользователя, на которую приходится момент t,
+// в его часовом поясе и с учетом часа начала дня
+func (u *User) DateAt(t time.Time) time.Time {
+	local := t.In(u.Location())
+	if local.Hour() < u.DayStartHour {
+		local = local.AddDate(0, 0, -1)
+	}
+	return DateOf(local)
+}
+
+// DayStart возвращает момент начала дня date пользователя
+func (u *User) DayStart(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), u.DayStartHour, 0, 0, 0, u.Location())
+}
+
+// Today возвращает текущую дату пользователя
+func (u *User) Today() time.Time {
+	return u.DateAt(time.Now())
+}
+
+// LogDate возвращает дату, в которую засчитывается выполнение, отмеченное сейчас:
+// в окне льготы после начала дня это еще предыдущий день
+func (u *User) LogDate() time.Time {
+	return u.LogDateAt(time.Now())
+}
+
+// LogDateAt возвращает дату, в которую засчитывается выполнение, отмеченное в момент t
+func (u *User) LogDateAt(t time.Time) time.Time {
+	return u.DateAt(t.Add(-u.LogGrace()))
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// moment парсит момент RFC 3339 для тестов
+func moment(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestDateAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		timezone  string
+		startHour int
+		at        string
+		want      string
+	}{
+		{"midnight starts the day", "UTC", 0, "2026-01-05T00:00:00Z", "2026-01-05"},
+		{"before midnight", "UTC", 0, "2026-01-04T23:59:59Z", "2026-01-04"},
+		{"before day start", "UTC", 4, "2026-01-05T03:59:00Z", "2026-01-04"},
+		{"at day start", "UTC", 4, "2026-01-05T04:00:00Z", "2026-01-05"},
+		{"local midnight east of UTC", "Asia/Tokyo", 0, "2026-01-05T15:00:00Z", "2026-01-06"},
+		{"before local midnight east of UTC", "Asia/Tokyo", 0, "2026-01-05T14:59:00Z", "2026-01-05"},
+		{"before local day start west of UTC", "America/New_York", 4, "2026-01-05T08:30:00Z", "2026-01-04"},
+		{"at local day start west of UTC", "America/New_York", 4, "2026-01-05T09:00:00Z", "2026-01-05"},
+		{"invalid timezone falls back to UTC", "Mars/Olympus", 0, "2026-01-05T01:00:00Z", "2026-01-05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &User{Timezone: tt.timezone, DayStartHour: tt.startHour}
+			if got := u.DateAt(moment(tt.at)); !got.Equal(date(tt.want)) {
+				t.Errorf("DateAt(%s) = %v, want %s", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogDateAt(t *testing.T) {
+	tests := []struct {
+		name         string
+		timezone     string
+		startHour    int
+		graceMinutes int
+		at           string
+		want         string
+	}{
+		{"inside grace window", "UTC", 4, 60, "2026-01-05T04:59:00Z", "2026-01-04"},
+		{"grace window end", "UTC", 4, 60, "2026-01-05T05:00:00Z", "2026-01-05"},
+		{"before day start", "UTC", 4, 60, "2026-01-05T03:00:00Z", "2026-01-04"},
+		{"no grace", "UTC", 4, 0, "2026-01-05T04:00:00Z", "2026-01-05"},
+		{"grace after local midnight", "Asia/Tokyo", 0, 90, "2026-01-05T16:29:00Z", "2026-01-05"},
+		{"grace end after local midnight", "Asia/Tokyo", 0, 90, "2026-01-05T16:30:00Z", "2026-01-06"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &User{Timezone: tt.timezone}
+			if err := u.SetDayBoundary(tt.startHour, tt.graceMinutes); err != nil {
+				t.Fatal(err)
+			}
+			if got := u.LogDateAt(moment(tt.at)); !got.Equal(date(tt.want)) {
+				t.Errorf("LogDateAt(%s) = %v, want %s", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayStart(t *testing.T) {
+	u := &User{Timezone: "Asia/Tokyo", DayStartHour: 4}
+	if got, want := u.DayStart(date("2026-01-06")), moment("2026-01-05T19:00:00Z"); !got.Equal(want) {
+		t.Errorf("DayStart = %v, want %v", got, want)
+	}
+}
+
+func TestSetDayBoundary(t *testing.T) {
+	tests := []struct {
+		startHour    int
+		graceMinutes int
+		wantErr      bool
+	}{
+		{startHour: 0, graceMinutes: 0},
+		{startHour: 23, graceMinutes: 720},
+		{startHour: -1, wantErr: true},
+		{startHour: 24, wantErr: true},
+		{startHour: 4, graceMinutes: -1, wantErr: true},
+		{startHour: 4, graceMinutes: 721, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := (&User{}).SetDayBoundary(tt.startHour, tt.graceMinutes)
+		if tt.wantErr != errors.Is(err, ErrInvalidDayBoundary) {
+			t.Errorf("SetDayBoundary(%d, %d) error = %v, wantErr %v", tt.startHour, tt.graceMinutes, err, tt.wantErr)
+		}
+	}
+}
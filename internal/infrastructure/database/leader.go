@@ -0,0 +1,196 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"HobitsService/internal/logger"
+)
+
+// LeaderElector выбирает лидера среди реплик с помощью session-level advisory lock.
+// Лидер держит отдельное соединение из пула, на котором взят pg_advisory_lock;
+// при обрыве соединения Postgres снимает блокировку, и ее забирает другая реплика.
+type LeaderElector struct {
+	pool     *pgxpool.Pool
+	name     string
+	key      int64
+	interval time.Duration
+
+	mu           sync.RWMutex
+	conn         *pgxpool.Conn
+	leaderCtx    context.Context
+	leaderCancel context.CancelFunc
+}
+
+// NewLeaderElector создает LeaderElector для блокировки с именем name.
+// interval - период попыток захвата блокировки и проверки, что она все еще удерживается.
+func NewLeaderElector(db *Database, name string, interval time.Duration) *LeaderElector {
+	return &LeaderElector{
+		pool:     db.Pool,
+		name:     name,
+		key:      advisoryLockKey(name),
+		interval: interval,
+	}
+}
+
+// Run захватывает и удерживает лидерство до отмены ctx, после чего освобождает блокировку
+func (e *LeaderElector) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if e.isHolding() {
+			if err := e.checkLock(ctx); err != nil && ctx.Err() == nil {
+				logger.Warn("Leadership lost", zap.String("lock", e.name), zap.Error(err))
+				e.drop()
+			}
+		} else {
+			if err := e.tryAcquire(ctx); err != nil && ctx.Err() == nil {
+				logger.Warn("Failed to acquire leadership", zap.String("lock", e.name), zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			e.resign()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// LeaderContext возвращает контекст, который отменяется при потере лидерства.
+// Второе значение false, если текущий экземпляр не является лидером.
+func (e *LeaderElector) LeaderContext() (context.Context, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.conn == nil {
+		return nil, false
+	}
+	return e.leaderCtx, true
+}
+
+// isHolding проверяет, удерживается ли блокировка этим экземпляром
+func (e *LeaderElector) isHolding() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.conn != nil
+}
+
+// tryAcquire пытается захватить блокировку на выделенном соединении
+func (e *LeaderElector) tryAcquire(ctx context.Context) error {
+	conn, err := e.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	var acquired bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&acquired); err != nil {
+		conn.Release()
+		return fmt.Errorf("failed to try advisory lock: %w", err)
+	}
+
+	if !acquired {
+		conn.Release()
+		return nil
+	}
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+
+	e.mu.Lock()
+	e.conn = conn
+	e.leaderCtx = leaderCtx
+	e.leaderCancel = cancel
+	e.mu.Unlock()
+
+	logger.Info("Leadership acquired", zap.String("lock", e.name))
+	return nil
+}
+
+// checkLock проверяет, что соединение живо и блокировка все еще числится за его сессией
+func (e *LeaderElector) checkLock(ctx context.Context) error {
+	e.mu.RLock()
+	conn := e.conn
+	e.mu.RUnlock()
+
+	checkCtx, cancel := context.WithTimeout(ctx, e.interval)
+	defer cancel()
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM pg_locks
+			WHERE locktype = 'advisory' AND pid = pg_backend_pid() AND granted
+				AND classid::bigint = $1 AND objid::bigint = $2 AND objsubid = 1
+		)
+	`
+
+	var held bool
+	classID, objID := int64(uint64(e.key)>>32), int64(uint64(e.key)&0xffffffff)
+	if err := conn.QueryRow(checkCtx, query, classID, objID).Scan(&held); err != nil {
+		return fmt.Errorf("failed to check advisory lock: %w", err)
+	}
+	if !held {
+		return fmt.Errorf("advisory lock is no longer held")
+	}
+
+	return nil
+}
+
+// drop сбрасывает лидерство после потери блокировки.
+// Соединение закрывается, а не возвращается в пул: если сессия еще жива,
+// ее закрытие гарантированно освобождает блокировку.
+func (e *LeaderElector) drop() {
+	e.mu.Lock()
+	conn, cancel := e.conn, e.leaderCancel
+	e.conn, e.leaderCtx, e.leaderCancel = nil, nil, nil
+	e.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	if conn != nil {
+		ctx, cancelClose := context.WithTimeout(context.Background(), e.interval)
+		defer cancelClose()
+		_ = conn.Hijack().Close(ctx)
+	}
+}
+
+// resign добровольно освобождает блокировку при остановке
+func (e *LeaderElector) resign() {
+	e.mu.Lock()
+	conn, cancel := e.conn, e.leaderCancel
+	e.conn, e.leaderCtx, e.leaderCancel = nil, nil, nil
+	e.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	if conn == nil {
+		return
+	}
+
+	ctx, cancelUnlock := context.WithTimeout(context.Background(), e.interval)
+	defer cancelUnlock()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", e.key); err != nil {
+		_ = conn.Hijack().Close(ctx)
+		return
+	}
+	conn.Release()
+	logger.Info("Leadership released", zap.String("lock", e.name))
+}
+
+// advisoryLockKey преобразует имя блокировки в bigint ключ advisory lock
+func advisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,75 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"HobitsService/internal/domain"
+)
+
+// webhookEvent тело запроса с событием привычки
+type webhookEvent struct {
+	ID        int                   `json:"id"`
+	Type      domain.HabitEventType `json:"type"`
+	HabitID   int                   `json:"habit_id"`
+	UserID    int                   `json:"user_id"`
+	CreatedAt time.Time             `json:"created_at"`
+	Payload   json.RawMessage       `json:"payload"`
+}
+
+// WebhookPublisher публикует события привычек POST запросом на URL потребителя.
+// Событие считается доставленным при ответе 2xx.
+type WebhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookPublisher создает новый WebhookPublisher
+func NewWebhookPublisher(url string, timeout time.Duration) *WebhookPublisher {
+	return &WebhookPublisher{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Publish отправляет событие; тип и ID события дублируются в заголовках X-Event-Type и X-Event-ID
+func (p *WebhookPublisher) Publish(ctx context.Context, event *domain.HabitEvent) error {
+	body, err := json.Marshal(webhookEvent{
+		ID:        event.ID,
+		Type:      event.Type,
+		HabitID:   event.HabitID,
+		UserID:    event.UserID,
+		CreatedAt: event.CreatedAt,
+		Payload:   event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Type", string(event.Type))
+	req.Header.Set("X-Event-ID", strconv.Itoa(event.ID))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// Тело дочитывается, чтобы соединение переиспользовалось
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
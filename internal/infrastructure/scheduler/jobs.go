@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
+)
+
+// generateReminders генерирует напоминания на сегодня для всех пользователей
+func (s *Scheduler) generateReminders(ctx context.Context, run *domain.JobRun) error {
+	return s.generateRemindersWith(ctx, run, func(ctx context.Context, userID int) error {
+		_, err := s.reminderService.GenerateRemindersForToday(ctx, userID)
+		return err
+	})
+}
+
+// replayReminders генерирует напоминания всех пользователей за пропущенную дату
+func (s *Scheduler) replayReminders(ctx context.Context, run *domain.JobRun, date time.Time) error {
+	return s.generateRemindersWith(ctx, run, func(ctx context.Context, userID int) error {
+		_, err := s.reminderService.GenerateRemindersForDate(ctx, userID, date)
+		return err
+	})
+}
+
+// generateRemindersWith вызывает generate для каждого пользователя
+func (s *Scheduler) generateRemindersWith(ctx context.Context, run *domain.JobRun, generate func(ctx context.Context, userID int) error) error {
+	users, err := s.userService.GetAllUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get all users: %w", err)
+	}
+
+	failed := 0
+	for _, user := range users {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		run.UsersProcessed++
+		if err := generate(ctx, user.ID); err != nil {
+			logger.Error("Failed to generate reminders for user", zap.Error(err), zap.Int("user_id", user.ID))
+			run.FailedCount++
+			failed++
+		}
+	}
+
+	logger.Info("Reminders generated for all users", zap.Int("users", len(users)), zap.Int("failed", failed))
+
+	if failed > 0 {
+		return fmt.Errorf("failed to generate reminders for %d of %d users", failed, len(users))
+	}
+	return nil
+}
+
+// checkStreaks проверяет все активные привычки и ставит пропуски в очередь на сброс
+func (s *Scheduler) checkStreaks(ctx context.Context, run *domain.JobRun) error {
+	return s.checkStreaksWith(ctx, run, s.streakResetService.CheckAndQueueStreakResets)
+}
+
+// replayStreakCheck проверяет все активные привычки так, как если бы проверка выполнялась в день date
+func (s *Scheduler) replayStreakCheck(ctx context.Context, run *domain.JobRun, date time.Time) error {
+	return s.checkStreaksWith(ctx, run, func(ctx context.Context) (int, int, error) {
+		return s.streakResetService.CheckAndQueueStreakResetsForDate(ctx, date)
+	})
+}
+
+// checkStreaksWith выполняет проверку check и записывает ее счетчики в run
+func (s *Scheduler) checkStreaksWith(ctx context.Context, run *domain.JobRun, check func(ctx context.Context) (checked, queued int, err error)) error {
+	checked, queued, err := check(ctx)
+	run.HabitsProcessed += checked
+	if err != nil {
+		return fmt.Errorf("failed to check streaks: %w", err)
+	}
+
+	logger.Info("Streak check completed", zap.Int("habits_checked", checked), zap.Int("queued", queued))
+	return nil
+}
+
+// processStreakResetQueue обрабатывает очередь на сброс стриков
+func (s *Scheduler) processStreakResetQueue(ctx context.Context, run *domain.JobRun) error {
+	processed, failed, err := s.streakResetService.ProcessQueueEntries(ctx)
+	run.HabitsProcessed += processed + failed
+	run.FailedCount += failed
+	return err
+}
+
+// checkGraduations проверяет критерии выработки у всех активных привычек
+func (s *Scheduler) checkGraduations(ctx context.Context, run *domain.JobRun) error {
+	checked, formed, failed, err := s.habitService.CheckGraduations(ctx)
+	run.HabitsProcessed += checked
+	run.FailedCount += failed
+	if err != nil {
+		return fmt.Errorf("failed to check graduations: %w", err)
+	}
+
+	logger.Info("Graduation check completed", zap.Int("habits_checked", checked), zap.Int("formed", formed), zap.Int("failed", failed))
+	return nil
+}
+
+// endChallenges деактивирует закончившиеся челленджи
+func (s *Scheduler) endChallenges(ctx context.Context, run *domain.JobRun) error {
+	checked, ended, failed, err := s.habitService.EndChallenges(ctx)
+	run.HabitsProcessed += checked
+	run.FailedCount += failed
+	if err != nil {
+		return fmt.Errorf("failed to end challenges: %w", err)
+	}
+
+	logger.Info("Challenge end check completed", zap.Int("habits_checked", checked), zap.Int("ended", ended), zap.Int("failed", failed))
+	return nil
+}
+
+// publishEvents публикует события привычек из outbox
+func (s *Scheduler) publishEvents(ctx context.Context, run *domain.JobRun) error {
+	published, failed, err := s.eventService.PublishPendingEvents(ctx)
+	run.HabitsProcessed += published + failed
+	run.FailedCount += failed
+	if err != nil {
+		return fmt.Errorf("failed to publish events: %w", err)
+	}
+
+	if published > 0 {
+		logger.Info("Events published", zap.Int("published", published))
+	}
+	return nil
+}
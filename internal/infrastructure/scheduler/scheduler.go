@@ -1,9 +1,75 @@
 package scheduler
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"HobitsService/internal/config"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/repository"
 	"HobitsService/internal/service"
 )
 
+// Имена периодических задач
+const (
+	JobGenerateReminders = "generate_reminders"
+	JobStreakCheck       = "streak_check"
+	JobStreakResetQueue  = "streak_reset_queue"
+	JobGraduationCheck   = "graduation_check"
+	JobChallengeEnd      = "challenge_end"
+	JobPublishEvents     = "publish_events"
+)
+
+// catchUpPollInterval как часто проверяется, не стал ли экземпляр лидером, чтобы догнать пропуски
+const catchUpPollInterval = time.Second
+
+// maxRunsLimit максимальное число запусков, возвращаемых ListRuns
+const maxRunsLimit = 100
+
+var (
+	// ErrUnknownJob возвращается при запуске несуществующей задачи
+	ErrUnknownJob = errors.New("unknown job")
+	// ErrJobAlreadyRunning возвращается, если предыдущий запуск задачи еще не завершился
+	ErrJobAlreadyRunning = errors.New("job is already running")
+	// ErrSchedulerStopped возвращается при запуске задачи после остановки scheduler
+	ErrSchedulerStopped = errors.New("scheduler is stopped")
+	// ErrNotLeader возвращается при ручном запуске задачи на экземпляре, который не является лидером
+	ErrNotLeader = errors.New("scheduler instance is not the leader")
+)
+
+// LeaderElector определяет, какая реплика выполняет задачи по расписанию
+type LeaderElector interface {
+	// Run участвует в выборах лидера до отмены ctx
+	Run(ctx context.Context)
+	// LeaderContext возвращает контекст, отменяемый при потере лидерства, и false, если экземпляр не лидер
+	LeaderContext() (context.Context, bool)
+}
+
+// JobFunc функция периодической задачи; счетчики обработанных записей накапливаются в run
+type JobFunc func(ctx context.Context, run *domain.JobRun) error
+
+// ReplayFunc повторяет пропущенный запуск задачи за конкретную дату
+type ReplayFunc func(ctx context.Context, run *domain.JobRun, date time.Time) error
+
+// job периодическая задача с cron расписанием
+type job struct {
+	name     string
+	spec     string
+	schedule cron.Schedule
+	run      JobFunc
+	// replay повторяет запуск за пропущенную дату; nil - достаточно одного обычного запуска
+	replay  ReplayFunc
+	running atomic.Bool
+}
+
 // Scheduler запускает периодические задачи
 type Scheduler struct {
 	habitService       *service.HabitService
@@ -11,158 +77,469 @@ type Scheduler struct {
 	reminderService    *service.ReminderService
 	streakResetService *service.StreakResetService
 	userService        *service.UserService
+	eventService       *service.EventService
+
+	runRepo    repository.SchedulerRunRepository
+	jobRunRepo repository.JobRunRepository
+
+	cfg      config.SchedulerConfig
+	leader   LeaderElector
+	location *time.Location
+	jobs     []*job
 
-	stopChan chan struct{}
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	startOnce sync.Once
+	stopOnce  sync.Once
 }
 
-// NewScheduler создает новый scheduler
+// NewScheduler создает новый scheduler и проверяет cron выражения из конфига.
+// Если leader равен nil, задачи по расписанию выполняются на каждом экземпляре.
 func NewScheduler(
+	cfg config.SchedulerConfig,
+	leader LeaderElector,
+	runRepo repository.SchedulerRunRepository,
+	jobRunRepo repository.JobRunRepository,
 	habitService *service.HabitService,
 	logService *service.LogService,
 	reminderService *service.ReminderService,
 	streakResetService *service.StreakResetService,
 	userService *service.UserService,
-) *Scheduler {
-	return &Scheduler{
+	eventService *service.EventService,
+) (*Scheduler, error) {
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scheduler timezone %q: %w", cfg.Timezone, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &Scheduler{
 		habitService:       habitService,
 		logService:         logService,
 		reminderService:    reminderService,
 		streakResetService: streakResetService,
 		userService:        userService,
-		stopChan:           make(chan struct{}),
-	}
-}
-
-//
-//// Start запускает scheduler с периодическими задачами
-//func (s *Scheduler) Start() {
-//	logger.Info("Scheduler started")
-//
-//	ctx := context.Background()
-//
-//	// Задача 1: Генерация напоминаний каждый день в 08:00
-//	go s.scheduleReminders(ctx)
-//
-//	// Задача 2: Проверка и сброс стриков каждый день в 23:59
-//	go s.scheduleStreakCheck(ctx)
-//
-//	// Задача 3: Обработка очереди сброса стриков каждый день в 00:30
-//	go s.processStreakResetQueue(ctx)
-//}
-//
-//// Stop останавливает scheduler
-//func (s *Scheduler) Stop() {
-//	logger.Info("Scheduler stopping")
-//	close(s.stopChan)
-//}
-//
-//// scheduleReminders генерирует напоминания в 08:00 каждый день
-//func (s *Scheduler) scheduleReminders(ctx context.Context) {
-//	ticker := time.NewTicker(1 * time.Hour)
-//	defer ticker.Stop()
-//
-//	for {
-//		select {
-//		case <-s.stopChan:
-//			logger.Info("Reminders scheduler stopped")
-//			return
-//		case <-ticker.C:
-//			now := time.Now()
-//
-//			// Проверяем, 08:00 ли сейчас
-//			if now.Hour() == 8 && now.Minute() < 60 {
-//				logger.Info("Generating reminders for all users")
-//
-//				// Получаем всех пользователей
-//				users, err := s.userService.GetAllUsers(ctx)
-//				if err != nil {
-//					logger.Error("Failed to get all users for reminder generation", zap.Error(err))
-//					continue
-//				}
-//
-//				// Для каждого пользователя генерируем напоминания
-//				for _, user := range users {
-//					_, err := s.reminderService.GenerateRemindersForToday(ctx, user.ID)
-//					if err != nil {
-//						logger.Error("Failed to generate reminders for user", zap.Error(err), zap.Int("user_id", user.ID))
-//					}
-//				}
-//
-//				logger.Info("Reminders generated for all users", zap.Int("count", len(users)))
-//			}
-//		}
-//	}
-//}
-//
-//// scheduleStreakCheck проверяет и добавляет стрики в очередь на сброс в 23:59
-//func (s *Scheduler) scheduleStreakCheck(ctx context.Context) {
-//	ticker := time.NewTicker(1 * time.Hour) // Проверяем каждый час
-//	defer ticker.Stop()
-//
-//	for {
-//		select {
-//		case <-s.stopChan:
-//			logger.Info("Streak check scheduler stopped")
-//			return
-//		case <-ticker.C:
-//			now := time.Now()
-//
-//			// Проверяем, 23:59 ли сейчас
-//			if now.Hour() == 23 && now.Minute() >= 55 {
-//				logger.Info("Checking streaks and queuing for reset")
-//
-//				// Получаем все активные привычки
-//				habits, err := s.habitService.GetAllActiveHabits(ctx)
-//				if err != nil {
-//					logger.Error("Failed to get all active habits for streak check", zap.Error(err))
-//					continue
-//				}
-//
-//				// Проверяем каждую привычку
-//				for _, habit := range habits {
-//					if err := s.streakResetService.CheckHabitStreak(ctx, habit.ID); err != nil {
-//						logger.Error("Failed to check streak for habit", zap.Error(err), zap.Int("habit_id", habit.ID))
-//					}
-//				}
-//
-//				logger.Info("Streak check completed", zap.Int("habits_checked", len(habits)))
-//			}
-//		}
-//	}
-//}
-//
-//// processStreakResetQueue обрабатывает очередь на сброс в 00:30
-//func (s *Scheduler) processStreakResetQueue(ctx context.Context) {
-//	ticker := time.NewTicker(1 * time.Hour) // Проверяем каждый час
-//	defer ticker.Stop()
-//
-//	for {
-//		select {
-//		case <-s.stopChan:
-//			logger.Info("Streak reset queue processor stopped")
-//			return
-//		case <-ticker.C:
-//			now := time.Now()
-//
-//			// Проверяем, 00:30 ли сейчас (+ небольшой диапазон)
-//			if now.Hour() == 0 && now.Minute() >= 25 && now.Minute() <= 35 {
-//				logger.Info("Processing streak reset queue")
-//
-//				if err := s.streakResetService.ProcessQueueEntries(ctx); err != nil {
-//					logger.Error("failed to process streak reset queue", zap.Error(err))
-//				}
-//			}
-//		}
-//	}
-//}
-//
-//// ScheduleRemindersForUser генерирует напоминания для конкретного пользователя
-//func (s *Scheduler) ScheduleRemindersForUser(ctx context.Context, userID int) error {
-//	_, err := s.reminderService.GenerateRemindersForToday(ctx, userID)
-//	return err
-//}
-//
-//// CheckHabitStreak проверяет стрик для конкретной привычки
-//func (s *Scheduler) CheckHabitStreak(ctx context.Context, habitID int) error {
-//	return s.streakResetService.CheckHabitStreak(ctx, habitID)
-//}
+		eventService:       eventService,
+		runRepo:            runRepo,
+		jobRunRepo:         jobRunRepo,
+		cfg:                cfg,
+		leader:             leader,
+		location:           location,
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+
+	specs := []struct {
+		name   string
+		spec   string
+		run    JobFunc
+		replay ReplayFunc
+	}{
+		{JobGenerateReminders, cfg.RemindersCron, s.generateReminders, s.replayReminders},
+		{JobStreakCheck, cfg.StreakCheckCron, s.checkStreaks, s.replayStreakCheck},
+		{JobStreakResetQueue, cfg.StreakQueueCron, s.processStreakResetQueue, nil},
+		{JobGraduationCheck, cfg.GraduationCheckCron, s.checkGraduations, nil},
+		{JobChallengeEnd, cfg.ChallengeEndCron, s.endChallenges, nil},
+		{JobPublishEvents, cfg.PublishEventsCron, s.publishEvents, nil},
+	}
+
+	for _, spec := range specs {
+		// Без потребителя событий задача не регистрируется, и события копятся в outbox
+		if spec.name == JobPublishEvents && !eventService.Enabled() {
+			logger.Info("Event publishing disabled, job not registered", zap.String("job", spec.name))
+			continue
+		}
+
+		schedule, err := cron.ParseStandard(spec.spec)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("invalid cron expression for job %s: %w", spec.name, err)
+		}
+		s.jobs = append(s.jobs, &job{
+			name:     spec.name,
+			spec:     spec.spec,
+			schedule: schedule,
+			run:      spec.run,
+			replay:   spec.replay,
+		})
+	}
+
+	return s, nil
+}
+
+// Start запускает scheduler с периодическими задачами
+func (s *Scheduler) Start() {
+	if !s.cfg.Enabled {
+		logger.Info("Scheduler disabled by config")
+		return
+	}
+
+	s.startOnce.Do(func() {
+		if s.leader != nil {
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				s.leader.Run(s.ctx)
+			}()
+		}
+
+		if s.cfg.CatchUpWindow > 0 {
+			s.wg.Add(1)
+			go s.catchUpLoop()
+		}
+
+		for _, j := range s.jobs {
+			s.wg.Add(1)
+			go s.loop(j)
+			logger.Info("Scheduler job registered", zap.String("job", j.name), zap.String("cron", j.spec))
+		}
+		logger.Info("Scheduler started", zap.String("timezone", s.location.String()))
+	})
+}
+
+// Stop останавливает scheduler: отменяет контекст выполняющихся задач и ждет их завершения
+func (s *Scheduler) Stop() {
+	s.stopOnce.Do(func() {
+		logger.Info("Scheduler stopping")
+		s.cancel()
+		s.wg.Wait()
+		logger.Info("Scheduler stopped")
+	})
+}
+
+// RunJob запускает задачу вне расписания и ждет ее завершения.
+// Если задача уже выполняется, возвращает ErrJobAlreadyRunning, если экземпляр не лидер - ErrNotLeader.
+func (s *Scheduler) RunJob(ctx context.Context, name string) (*domain.JobRun, error) {
+	j := s.findJob(name)
+	if j == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownJob, name)
+	}
+	leaderCtx, ok := s.leaderContext()
+	if !ok {
+		return nil, ErrNotLeader
+	}
+
+	// Ручной запуск тоже прерывается при остановке scheduler и потере лидерства
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(s.ctx, cancel)
+	defer stop()
+	stopLeader := context.AfterFunc(leaderCtx, cancel)
+	defer stopLeader()
+
+	run, err := s.start(ctx, j, domain.JobRunTriggerManual)
+	if err != nil {
+		return nil, err
+	}
+	return run, s.perform(ctx, j, run, j.run)
+}
+
+// TriggerJob запускает задачу вне расписания в фоне и сразу возвращает запись о запуске.
+// Ход выполнения можно отслеживать через GetRun. Запускать задачи может только лидер,
+// иначе ручной запуск пересекся бы с запуском по расписанию на другой реплике.
+func (s *Scheduler) TriggerJob(ctx context.Context, name string) (*domain.JobRun, error) {
+	j := s.findJob(name)
+	if j == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownJob, name)
+	}
+	if s.ctx.Err() != nil {
+		return nil, ErrSchedulerStopped
+	}
+	leaderCtx, ok := s.leaderContext()
+	if !ok {
+		return nil, ErrNotLeader
+	}
+
+	run, err := s.start(ctx, j, domain.JobRunTriggerManual)
+	if err != nil {
+		return nil, err
+	}
+
+	// Копия возвращается вызывающему, пока оригинал обновляется выполняющейся задачей
+	snapshot := *run
+
+	// Фоновый запуск живет дольше запроса и прерывается остановкой scheduler или потерей лидерства
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		runCtx, cancel := context.WithCancel(s.ctx)
+		defer cancel()
+		stop := context.AfterFunc(leaderCtx, cancel)
+		defer stop()
+		_ = s.perform(runCtx, j, run, j.run)
+	}()
+
+	return &snapshot, nil
+}
+
+// ListRuns возвращает последние запуски задачи, пустое имя - по всем задачам
+func (s *Scheduler) ListRuns(ctx context.Context, jobName string, limit int) ([]*domain.JobRun, error) {
+	if jobName != "" && s.findJob(jobName) == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownJob, jobName)
+	}
+	if limit <= 0 || limit > maxRunsLimit {
+		limit = maxRunsLimit
+	}
+	return s.jobRunRepo.GetJobRuns(ctx, jobName, limit)
+}
+
+// GetRun возвращает запуск задачи по ID
+func (s *Scheduler) GetRun(ctx context.Context, id int) (*domain.JobRun, error) {
+	return s.jobRunRepo.GetJobRunByID(ctx, id)
+}
+
+// loop ждет очередного срабатывания расписания задачи и выполняет ее
+func (s *Scheduler) loop(j *job) {
+	defer s.wg.Done()
+
+	for {
+		next := j.schedule.Next(time.Now().In(s.location))
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			s.runScheduled(j)
+		}
+	}
+}
+
+// leaderContext возвращает контекст лидерства и false, если экземпляр не лидер.
+// Без выборов лидера каждый экземпляр считается лидером.
+func (s *Scheduler) leaderContext() (context.Context, bool) {
+	if s.leader == nil {
+		return s.ctx, true
+	}
+	return s.leader.LeaderContext()
+}
+
+// runScheduled выполняет задачу по расписанию, если текущий экземпляр является лидером.
+// При потере лидерства во время выполнения контекст задачи отменяется.
+func (s *Scheduler) runScheduled(j *job) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	if s.leader != nil {
+		leaderCtx, ok := s.leader.LeaderContext()
+		if !ok {
+			logger.Debug("Scheduler job skipped: not a leader", zap.String("job", j.name))
+			return
+		}
+		stop := context.AfterFunc(leaderCtx, cancel)
+		defer stop()
+	}
+
+	run, err := s.start(ctx, j, domain.JobRunTriggerSchedule)
+	if errors.Is(err, ErrJobAlreadyRunning) {
+		logger.Warn("Scheduler job skipped: previous run still in progress", zap.String("job", j.name))
+		return
+	}
+	_ = s.perform(ctx, j, run, j.run)
+}
+
+// catchUpLoop догоняет пропущенные запуски при старте, а при выборах лидера -
+// каждый раз, когда этот экземпляр становится лидером (в том числе при перехвате лидерства)
+func (s *Scheduler) catchUpLoop() {
+	defer s.wg.Done()
+
+	if s.leader == nil {
+		s.catchUp(s.ctx)
+		return
+	}
+
+	ticker := time.NewTicker(catchUpPollInterval)
+	defer ticker.Stop()
+
+	var handled context.Context
+	for {
+		if leaderCtx, ok := s.leader.LeaderContext(); ok && leaderCtx != handled {
+			handled = leaderCtx
+
+			ctx, cancel := context.WithCancel(s.ctx)
+			stop := context.AfterFunc(leaderCtx, cancel)
+			s.catchUp(ctx)
+			stop()
+			cancel()
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// catchUp находит окна расписания, пропущенные с последнего успешного запуска, и повторяет их
+func (s *Scheduler) catchUp(ctx context.Context) {
+	runs, err := s.runRepo.GetSchedulerRuns(ctx)
+	if err != nil {
+		logger.Error("Failed to get last scheduler runs", zap.Error(err))
+		return
+	}
+
+	lastSuccess := make(map[string]time.Time, len(runs))
+	for _, run := range runs {
+		lastSuccess[run.JobName] = run.LastSuccessAt
+	}
+
+	now := time.Now().In(s.location)
+	for _, j := range s.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		last, ok := lastSuccess[j.name]
+		if !ok {
+			// Задача еще ни разу не запускалась - считаем точкой отсчета текущий момент
+			s.recordSuccess(ctx, j, now)
+			continue
+		}
+
+		dates := s.missedDates(j, last, now)
+		if len(dates) == 0 {
+			continue
+		}
+
+		logger.Warn("Scheduler job missed runs, catching up",
+			zap.String("job", j.name),
+			zap.Time("last_success_at", last),
+			zap.Int("missed_dates", len(dates)),
+		)
+
+		run, err := s.start(ctx, j, domain.JobRunTriggerCatchUp)
+		if errors.Is(err, ErrJobAlreadyRunning) {
+			logger.Warn("Scheduler catch-up skipped: job is running", zap.String("job", j.name))
+			continue
+		}
+
+		_ = s.perform(ctx, j, run, func(ctx context.Context, run *domain.JobRun) error {
+			if j.replay == nil {
+				return j.run(ctx, run)
+			}
+			for _, date := range dates {
+				if err := j.replay(ctx, run, date); err != nil {
+					return fmt.Errorf("failed to replay %s: %w", date.Format("2006-01-02"), err)
+				}
+			}
+			return nil
+		})
+	}
+}
+
+// missedDates возвращает даты срабатываний расписания в интервале (since, now] по порядку.
+// Интервал ограничен окном SchedulerConfig.CatchUpWindow.
+func (s *Scheduler) missedDates(j *job, since, now time.Time) []time.Time {
+	if earliest := now.Add(-s.cfg.CatchUpWindow); since.Before(earliest) {
+		since = earliest
+	}
+
+	var dates []time.Time
+	seen := make(map[time.Time]bool)
+	for t := j.schedule.Next(since.In(s.location)); !t.After(now); t = j.schedule.Next(t) {
+		date := domain.DateOf(t)
+		if !seen[date] {
+			seen[date] = true
+			dates = append(dates, date)
+		}
+	}
+
+	return dates
+}
+
+// recordSuccess сохраняет время последнего успешного запуска задачи
+func (s *Scheduler) recordSuccess(ctx context.Context, j *job, startedAt time.Time) {
+	// Запись должна сохраниться, даже если контекст задачи уже отменен
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	if _, err := s.runRepo.SaveSchedulerRun(ctx, domain.NewSchedulerRun(j.name, startedAt)); err != nil {
+		logger.Error("Failed to save scheduler run", zap.String("job", j.name), zap.Error(err))
+	}
+}
+
+// start помечает задачу выполняющейся и сохраняет запись о запуске.
+// Если сохранить запись не удалось, задача все равно выполняется - история не должна блокировать работу.
+func (s *Scheduler) start(ctx context.Context, j *job, trigger domain.JobRunTrigger) (*domain.JobRun, error) {
+	if !j.running.CompareAndSwap(false, true) {
+		return nil, ErrJobAlreadyRunning
+	}
+
+	run := domain.NewJobRun(j.name, trigger)
+	saved, err := s.jobRunRepo.CreateJobRun(ctx, run)
+	if err != nil {
+		logger.Error("Failed to save job run", zap.String("job", j.name), zap.Error(err))
+		return run, nil
+	}
+	return saved, nil
+}
+
+// perform выполняет задачу, запущенную через start, с таймаутом и сохраняет результат запуска.
+// При успехе запуска по расписанию или догоняющего время его начала сохраняется как последний успешный запуск;
+// ручной запуск его не сдвигает, иначе пропущенные окна расписания не будут повторены.
+func (s *Scheduler) perform(ctx context.Context, j *job, run *domain.JobRun, fn JobFunc) (err error) {
+	defer j.running.Store(false)
+
+	if s.cfg.JobTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.JobTimeout)
+		defer cancel()
+	}
+
+	logger.Info("Scheduler job started", zap.String("job", j.name), zap.String("trigger", string(run.Trigger)), zap.Int("run_id", run.ID))
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job %s panicked: %v", j.name, r)
+		}
+
+		run.Finish(err)
+		s.saveRun(ctx, run)
+
+		if err != nil {
+			logger.Error("Scheduler job failed", zap.String("job", j.name), zap.Duration("duration", run.Duration()), zap.Error(err))
+			return
+		}
+		logger.Info("Scheduler job completed",
+			zap.String("job", j.name),
+			zap.Duration("duration", run.Duration()),
+			zap.Int("users_processed", run.UsersProcessed),
+			zap.Int("habits_processed", run.HabitsProcessed),
+			zap.Int("failed", run.FailedCount),
+		)
+		if run.Trigger != domain.JobRunTriggerManual {
+			s.recordSuccess(ctx, j, run.StartedAt)
+		}
+	}()
+
+	return fn(ctx, run)
+}
+
+// saveRun сохраняет результат запуска задачи
+func (s *Scheduler) saveRun(ctx context.Context, run *domain.JobRun) {
+	if run.ID == 0 {
+		return
+	}
+
+	// Результат должен сохраниться, даже если контекст задачи уже отменен
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	if _, err := s.jobRunRepo.UpdateJobRun(ctx, run); err != nil {
+		logger.Error("Failed to update job run", zap.String("job", run.JobName), zap.Int("run_id", run.ID), zap.Error(err))
+	}
+}
+
+// findJob ищет задачу по имени
+func (s *Scheduler) findJob(name string) *job {
+	for _, j := range s.jobs {
+		if j.name == name {
+			return j
+		}
+	}
+	return nil
+}
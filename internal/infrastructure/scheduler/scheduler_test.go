@@ -0,0 +1,232 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"HobitsService/internal/config"
+	"HobitsService/internal/domain"
+)
+
+// fakeRunRepo хранит последние успешные запуски в памяти
+type fakeRunRepo struct {
+	mu   sync.Mutex
+	runs map[string]time.Time
+}
+
+func (r *fakeRunRepo) GetSchedulerRuns(ctx context.Context) ([]*domain.SchedulerRun, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var runs []*domain.SchedulerRun
+	for name, at := range r.runs {
+		runs = append(runs, domain.NewSchedulerRun(name, at))
+	}
+	return runs, nil
+}
+
+func (r *fakeRunRepo) SaveSchedulerRun(ctx context.Context, run *domain.SchedulerRun) (*domain.SchedulerRun, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.runs[run.JobName] = run.LastSuccessAt
+	return run, nil
+}
+
+// fakeJobRunRepo не сохраняет историю запусков
+type fakeJobRunRepo struct{}
+
+func (fakeJobRunRepo) CreateJobRun(ctx context.Context, run *domain.JobRun) (*domain.JobRun, error) {
+	run.ID = 1
+	return run, nil
+}
+
+func (fakeJobRunRepo) GetJobRunByID(ctx context.Context, id int) (*domain.JobRun, error) {
+	return nil, nil
+}
+
+func (fakeJobRunRepo) GetJobRuns(ctx context.Context, jobName string, limit int) ([]*domain.JobRun, error) {
+	return nil, nil
+}
+
+func (fakeJobRunRepo) UpdateJobRun(ctx context.Context, run *domain.JobRun) (*domain.JobRun, error) {
+	return run, nil
+}
+
+// at парсит момент "2006-01-02 15:04" в часовом поясе loc
+func at(s string, loc *time.Location) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, loc)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func testJob(spec string) *job {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		panic(err)
+	}
+	return &job{name: "test", spec: spec, schedule: schedule}
+}
+
+func TestMissedDates(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		location *time.Location
+		spec     string
+		since    string
+		now      string
+		want     []string
+	}{
+		{
+			name:     "run already done today",
+			location: time.UTC,
+			spec:     "0 8 * * *",
+			since:    "2026-01-10 08:00",
+			now:      "2026-01-10 09:00",
+		},
+		{
+			name:     "today's run is not due yet",
+			location: time.UTC,
+			spec:     "0 8 * * *",
+			since:    "2026-01-09 08:00",
+			now:      "2026-01-10 07:00",
+		},
+		{
+			name:     "gap of several days",
+			location: time.UTC,
+			spec:     "0 8 * * *",
+			since:    "2026-01-07 08:00",
+			now:      "2026-01-10 09:00",
+			want:     []string{"2026-01-08", "2026-01-09", "2026-01-10"},
+		},
+		{
+			name:     "gap is limited by catch-up window",
+			location: time.UTC,
+			spec:     "0 8 * * *",
+			since:    "2025-12-01 08:00",
+			now:      "2026-01-10 09:00",
+			want:     []string{"2026-01-04", "2026-01-05", "2026-01-06", "2026-01-07", "2026-01-08", "2026-01-09", "2026-01-10"},
+		},
+		{
+			name:     "hourly job gives one date per day",
+			location: time.UTC,
+			spec:     "5 * * * *",
+			since:    "2026-01-09 22:00",
+			now:      "2026-01-10 02:00",
+			want:     []string{"2026-01-09", "2026-01-10"},
+		},
+		{
+			name:     "dates in scheduler timezone",
+			location: moscow,
+			spec:     "30 0 * * *",
+			since:    "2026-01-09 00:30",
+			now:      "2026-01-11 01:00",
+			want:     []string{"2026-01-10", "2026-01-11"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				cfg:      config.SchedulerConfig{CatchUpWindow: 7 * 24 * time.Hour},
+				location: tt.location,
+			}
+
+			got := s.missedDates(testJob(tt.spec), at(tt.since, tt.location), at(tt.now, tt.location))
+			if len(got) != len(tt.want) {
+				t.Fatalf("missedDates = %v, want %v", got, tt.want)
+			}
+			for i, want := range tt.want {
+				if got[i].Format("2006-01-02") != want {
+					t.Errorf("missedDates[%d] = %v, want %s", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestCatchUp(t *testing.T) {
+	tests := []struct {
+		name      string
+		ranBefore bool
+		// lastSuccess сдвиг последнего успешного запуска от текущего момента
+		lastSuccess time.Duration
+		wantReplays int
+	}{
+		{
+			name:        "no previous run",
+			wantReplays: 0,
+		},
+		{
+			name:        "gap of several days",
+			ranBefore:   true,
+			lastSuccess: -72 * time.Hour,
+			wantReplays: 3,
+		},
+		{
+			name:        "run already done today",
+			ranBefore:   true,
+			wantReplays: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runRepo := &fakeRunRepo{runs: map[string]time.Time{}}
+			if tt.ranBefore {
+				runRepo.runs["test"] = time.Now().Add(tt.lastSuccess)
+			}
+
+			var replayed []time.Time
+			j := testJob("0 8 * * *")
+			j.run = func(ctx context.Context, run *domain.JobRun) error {
+				t.Error("catch-up should replay missed dates, not run the job")
+				return nil
+			}
+			j.replay = func(ctx context.Context, run *domain.JobRun, date time.Time) error {
+				replayed = append(replayed, date)
+				return nil
+			}
+
+			s := &Scheduler{
+				runRepo:    runRepo,
+				jobRunRepo: fakeJobRunRepo{},
+				cfg:        config.SchedulerConfig{CatchUpWindow: 7 * 24 * time.Hour},
+				location:   time.UTC,
+				jobs:       []*job{j},
+			}
+			before := time.Now()
+			s.catchUp(context.Background())
+
+			if len(replayed) != tt.wantReplays {
+				t.Fatalf("replayed %v, want %d dates", replayed, tt.wantReplays)
+			}
+			for i := 1; i < len(replayed); i++ {
+				if !replayed[i].After(replayed[i-1]) {
+					t.Errorf("replayed dates are not in order: %v", replayed)
+				}
+			}
+
+			// Последний успешный запуск сдвигается к текущему моменту, и повторный catch-up ничего не делает
+			if last := runRepo.runs["test"]; !tt.ranBefore || tt.wantReplays > 0 {
+				if last.Before(before) {
+					t.Errorf("last success = %v, want at least %v", last, before)
+				}
+			}
+			replayed = nil
+			s.catchUp(context.Background())
+			if len(replayed) != 0 {
+				t.Errorf("second catch-up replayed %v", replayed)
+			}
+		})
+	}
+}
@@ -2,8 +2,12 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"HobitsService/internal/domain"
@@ -24,12 +28,26 @@ func (r *HabitRepository) CreateHabit(ctx context.Context, habit *domain.Habit)
 	query := `
 		INSERT INTO habits (
 			user_id, name, description, goal, frequency, weekly_days, monthly_days,
-			current_streak, best_streak, is_active, is_completed, created_at, updated_at
+			current_streak, best_streak, is_active, is_completed, created_at, updated_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)
 		RETURNING id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
 			current_streak, best_streak, last_completed_date, last_checked_date,
-			is_active, is_completed, created_at, updated_at, completed_at
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
 	`
 
 	row := r.pool.QueryRow(ctx, query,
@@ -46,6 +64,20 @@ func (r *HabitRepository) CreateHabit(ctx context.Context, habit *domain.Habit)
 		habit.IsCompleted,
 		habit.CreatedAt,
 		habit.UpdatedAt,
+		habit.IntervalDays,
+		habit.AnchorDate,
+		habit.QuotaTarget,
+		habit.QuotaPeriod,
+		habit.RRule,
+		habit.RRuleStart,
+		habit.TargetValue,
+		habit.Unit,
+		habit.DailyCount,
+		habit.GraduationStreak,
+		habit.GraduationRate,
+		habit.GraduationWeeks,
+		habit.StartsOn,
+		habit.EndsOn,
 	)
 
 	var result domain.Habit
@@ -67,6 +99,20 @@ func (r *HabitRepository) CreateHabit(ctx context.Context, habit *domain.Habit)
 		&result.CreatedAt,
 		&result.UpdatedAt,
 		&result.CompletedAt,
+		&result.IntervalDays,
+		&result.AnchorDate,
+		&result.QuotaTarget,
+		&result.QuotaPeriod,
+		&result.RRule,
+		&result.RRuleStart,
+		&result.TargetValue,
+		&result.Unit,
+		&result.DailyCount,
+		&result.GraduationStreak,
+		&result.GraduationRate,
+		&result.GraduationWeeks,
+		&result.StartsOn,
+		&result.EndsOn,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create habit: %w", err)
@@ -80,7 +126,14 @@ func (r *HabitRepository) GetHabitByID(ctx context.Context, id int) (*domain.Hab
 	query := `
 		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
 			current_streak, best_streak, last_completed_date, last_checked_date,
-			is_active, is_completed, created_at, updated_at, completed_at
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
 		FROM habits
 		WHERE id = $1
 	`
@@ -106,6 +159,20 @@ func (r *HabitRepository) GetHabitByID(ctx context.Context, id int) (*domain.Hab
 		&habit.CreatedAt,
 		&habit.UpdatedAt,
 		&habit.CompletedAt,
+		&habit.IntervalDays,
+		&habit.AnchorDate,
+		&habit.QuotaTarget,
+		&habit.QuotaPeriod,
+		&habit.RRule,
+		&habit.RRuleStart,
+		&habit.TargetValue,
+		&habit.Unit,
+		&habit.DailyCount,
+		&habit.GraduationStreak,
+		&habit.GraduationRate,
+		&habit.GraduationWeeks,
+		&habit.StartsOn,
+		&habit.EndsOn,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get habit by id: %w", err)
@@ -119,7 +186,14 @@ func (r *HabitRepository) GetHabitsByUserID(ctx context.Context, userID int) ([]
 	query := `
 		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
 			current_streak, best_streak, last_completed_date, last_checked_date,
-			is_active, is_completed, created_at, updated_at, completed_at
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
 		FROM habits
 		WHERE user_id = $1
 		ORDER BY created_at DESC
@@ -152,6 +226,20 @@ func (r *HabitRepository) GetHabitsByUserID(ctx context.Context, userID int) ([]
 			&habit.CreatedAt,
 			&habit.UpdatedAt,
 			&habit.CompletedAt,
+			&habit.IntervalDays,
+			&habit.AnchorDate,
+			&habit.QuotaTarget,
+			&habit.QuotaPeriod,
+			&habit.RRule,
+			&habit.RRuleStart,
+			&habit.TargetValue,
+			&habit.Unit,
+			&habit.DailyCount,
+			&habit.GraduationStreak,
+			&habit.GraduationRate,
+			&habit.GraduationWeeks,
+			&habit.StartsOn,
+			&habit.EndsOn,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit: %w", err)
@@ -166,12 +254,341 @@ func (r *HabitRepository) GetHabitsByUserID(ctx context.Context, userID int) ([]
 	return habits, nil
 }
 
+// GetFormedHabitsByUserID получает выработанные привычки пользователя, последние выработанные первыми
+func (r *HabitRepository) GetFormedHabitsByUserID(ctx context.Context, userID int) ([]*domain.Habit, error) {
+	query := `
+		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
+			current_streak, best_streak, last_completed_date, last_checked_date,
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
+		FROM habits
+		WHERE user_id = $1 AND is_completed
+		ORDER BY completed_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get formed habits: %w", err)
+	}
+	defer rows.Close()
+
+	var habits []*domain.Habit
+	for rows.Next() {
+		var habit domain.Habit
+		err := rows.Scan(
+			&habit.ID,
+			&habit.UserID,
+			&habit.Name,
+			&habit.Description,
+			&habit.Goal,
+			&habit.Frequency,
+			&habit.WeeklyDays,
+			&habit.MonthlyDays,
+			&habit.CurrentStreak,
+			&habit.BestStreak,
+			&habit.LastCompletedDate,
+			&habit.LastCheckedDate,
+			&habit.IsActive,
+			&habit.IsCompleted,
+			&habit.CreatedAt,
+			&habit.UpdatedAt,
+			&habit.CompletedAt,
+			&habit.IntervalDays,
+			&habit.AnchorDate,
+			&habit.QuotaTarget,
+			&habit.QuotaPeriod,
+			&habit.RRule,
+			&habit.RRuleStart,
+			&habit.TargetValue,
+			&habit.Unit,
+			&habit.DailyCount,
+			&habit.GraduationStreak,
+			&habit.GraduationRate,
+			&habit.GraduationWeeks,
+			&habit.StartsOn,
+			&habit.EndsOn,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit: %w", err)
+		}
+		habits = append(habits, &habit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating habits: %w", err)
+	}
+
+	return habits, nil
+}
+
+// MarkHabitFormed отмечает привычку выработанной и в том же запросе сохраняет событие в outbox.
+// Возвращает nil, если привычка уже была отмечена.
+func (r *HabitRepository) MarkHabitFormed(ctx context.Context, habit *domain.Habit, event *domain.HabitEvent) (*domain.Habit, error) {
+	query := `
+		WITH formed AS (
+			UPDATE habits
+			SET is_completed = TRUE, completed_at = $2, updated_at = $2
+			WHERE id = $1 AND NOT is_completed
+			RETURNING id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
+				current_streak, best_streak, last_completed_date, last_checked_date,
+				is_active, is_completed, created_at, updated_at, completed_at,
+				interval_days, anchor_date,
+				quota_target, quota_period,
+				rrule, rrule_start,
+				target_value, unit,
+				daily_count,
+				graduation_streak, graduation_rate, graduation_weeks,
+				starts_on, ends_on
+		), event AS (
+			INSERT INTO habit_events (habit_id, user_id, event_type, payload, created_at)
+			SELECT id, user_id, $3, $4, $5
+			FROM formed
+		)
+		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
+			current_streak, best_streak, last_completed_date, last_checked_date,
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
+		FROM formed
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		habit.ID,
+		habit.CompletedAt,
+		event.Type,
+		event.Payload,
+		event.CreatedAt,
+	)
+
+	var result domain.Habit
+	err := row.Scan(
+		&result.ID,
+		&result.UserID,
+		&result.Name,
+		&result.Description,
+		&result.Goal,
+		&result.Frequency,
+		&result.WeeklyDays,
+		&result.MonthlyDays,
+		&result.CurrentStreak,
+		&result.BestStreak,
+		&result.LastCompletedDate,
+		&result.LastCheckedDate,
+		&result.IsActive,
+		&result.IsCompleted,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.CompletedAt,
+		&result.IntervalDays,
+		&result.AnchorDate,
+		&result.QuotaTarget,
+		&result.QuotaPeriod,
+		&result.RRule,
+		&result.RRuleStart,
+		&result.TargetValue,
+		&result.Unit,
+		&result.DailyCount,
+		&result.GraduationStreak,
+		&result.GraduationRate,
+		&result.GraduationWeeks,
+		&result.StartsOn,
+		&result.EndsOn,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark habit formed: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetChallengesEndedBefore получает активные привычки-челленджи с датой окончания раньше date
+func (r *HabitRepository) GetChallengesEndedBefore(ctx context.Context, date time.Time) ([]*domain.Habit, error) {
+	query := `
+		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
+			current_streak, best_streak, last_completed_date, last_checked_date,
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
+		FROM habits
+		WHERE is_active AND ends_on < $1
+		ORDER BY id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ended challenges: %w", err)
+	}
+	defer rows.Close()
+
+	var habits []*domain.Habit
+	for rows.Next() {
+		var habit domain.Habit
+		err := rows.Scan(
+			&habit.ID,
+			&habit.UserID,
+			&habit.Name,
+			&habit.Description,
+			&habit.Goal,
+			&habit.Frequency,
+			&habit.WeeklyDays,
+			&habit.MonthlyDays,
+			&habit.CurrentStreak,
+			&habit.BestStreak,
+			&habit.LastCompletedDate,
+			&habit.LastCheckedDate,
+			&habit.IsActive,
+			&habit.IsCompleted,
+			&habit.CreatedAt,
+			&habit.UpdatedAt,
+			&habit.CompletedAt,
+			&habit.IntervalDays,
+			&habit.AnchorDate,
+			&habit.QuotaTarget,
+			&habit.QuotaPeriod,
+			&habit.RRule,
+			&habit.RRuleStart,
+			&habit.TargetValue,
+			&habit.Unit,
+			&habit.DailyCount,
+			&habit.GraduationStreak,
+			&habit.GraduationRate,
+			&habit.GraduationWeeks,
+			&habit.StartsOn,
+			&habit.EndsOn,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit: %w", err)
+		}
+		habits = append(habits, &habit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating habits: %w", err)
+	}
+
+	return habits, nil
+}
+
+// EndChallenge деактивирует завершившуюся привычку-челлендж и в том же запросе сохраняет событие в outbox.
+// Возвращает nil, если привычка уже была деактивирована.
+func (r *HabitRepository) EndChallenge(ctx context.Context, habit *domain.Habit, event *domain.HabitEvent) (*domain.Habit, error) {
+	query := `
+		WITH ended AS (
+			UPDATE habits
+			SET is_active = FALSE, updated_at = $2
+			WHERE id = $1 AND is_active
+			RETURNING id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
+				current_streak, best_streak, last_completed_date, last_checked_date,
+				is_active, is_completed, created_at, updated_at, completed_at,
+				interval_days, anchor_date,
+				quota_target, quota_period,
+				rrule, rrule_start,
+				target_value, unit,
+				daily_count,
+				graduation_streak, graduation_rate, graduation_weeks,
+				starts_on, ends_on
+		), event AS (
+			INSERT INTO habit_events (habit_id, user_id, event_type, payload, created_at)
+			SELECT id, user_id, $3, $4, $5
+			FROM ended
+		)
+		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
+			current_streak, best_streak, last_completed_date, last_checked_date,
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
+		FROM ended
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		habit.ID,
+		habit.UpdatedAt,
+		event.Type,
+		event.Payload,
+		event.CreatedAt,
+	)
+
+	var result domain.Habit
+	err := row.Scan(
+		&result.ID,
+		&result.UserID,
+		&result.Name,
+		&result.Description,
+		&result.Goal,
+		&result.Frequency,
+		&result.WeeklyDays,
+		&result.MonthlyDays,
+		&result.CurrentStreak,
+		&result.BestStreak,
+		&result.LastCompletedDate,
+		&result.LastCheckedDate,
+		&result.IsActive,
+		&result.IsCompleted,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.CompletedAt,
+		&result.IntervalDays,
+		&result.AnchorDate,
+		&result.QuotaTarget,
+		&result.QuotaPeriod,
+		&result.RRule,
+		&result.RRuleStart,
+		&result.TargetValue,
+		&result.Unit,
+		&result.DailyCount,
+		&result.GraduationStreak,
+		&result.GraduationRate,
+		&result.GraduationWeeks,
+		&result.StartsOn,
+		&result.EndsOn,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to end challenge: %w", err)
+	}
+
+	return &result, nil
+}
+
 // GetActiveHabitsByUserID получает активные привычки пользователя
 func (r *HabitRepository) GetActiveHabitsByUserID(ctx context.Context, userID int) ([]*domain.Habit, error) {
 	query := `
 		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
 			current_streak, best_streak, last_completed_date, last_checked_date,
-			is_active, is_completed, created_at, updated_at, completed_at
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
 		FROM habits
 		WHERE user_id = $1 AND is_active = true
 		ORDER BY created_at DESC
@@ -204,6 +621,20 @@ func (r *HabitRepository) GetActiveHabitsByUserID(ctx context.Context, userID in
 			&habit.CreatedAt,
 			&habit.UpdatedAt,
 			&habit.CompletedAt,
+			&habit.IntervalDays,
+			&habit.AnchorDate,
+			&habit.QuotaTarget,
+			&habit.QuotaPeriod,
+			&habit.RRule,
+			&habit.RRuleStart,
+			&habit.TargetValue,
+			&habit.Unit,
+			&habit.DailyCount,
+			&habit.GraduationStreak,
+			&habit.GraduationRate,
+			&habit.GraduationWeeks,
+			&habit.StartsOn,
+			&habit.EndsOn,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit: %w", err)
@@ -225,11 +656,25 @@ func (r *HabitRepository) UpdateHabit(ctx context.Context, habit *domain.Habit)
 		SET name = $1, description = $2, goal = $3, frequency = $4, weekly_days = $5,
 			monthly_days = $6, current_streak = $7, best_streak = $8,
 			last_completed_date = $9, last_checked_date = $10,
-			is_active = $11, is_completed = $12, updated_at = $13, completed_at = $14
-		WHERE id = $15
+			is_active = $11, is_completed = $12, updated_at = $13, completed_at = $14,
+			interval_days = $15, anchor_date = $16,
+			quota_target = $17, quota_period = $18,
+			rrule = $19, rrule_start = $20,
+			target_value = $21, unit = $22,
+			daily_count = $23,
+			graduation_streak = $24, graduation_rate = $25, graduation_weeks = $26,
+			starts_on = $27, ends_on = $28
+		WHERE id = $29
 		RETURNING id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
 			current_streak, best_streak, last_completed_date, last_checked_date,
-			is_active, is_completed, created_at, updated_at, completed_at
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
 	`
 
 	row := r.pool.QueryRow(ctx, query,
@@ -247,6 +692,20 @@ func (r *HabitRepository) UpdateHabit(ctx context.Context, habit *domain.Habit)
 		habit.IsCompleted,
 		habit.UpdatedAt,
 		habit.CompletedAt,
+		habit.IntervalDays,
+		habit.AnchorDate,
+		habit.QuotaTarget,
+		habit.QuotaPeriod,
+		habit.RRule,
+		habit.RRuleStart,
+		habit.TargetValue,
+		habit.Unit,
+		habit.DailyCount,
+		habit.GraduationStreak,
+		habit.GraduationRate,
+		habit.GraduationWeeks,
+		habit.StartsOn,
+		habit.EndsOn,
 		habit.ID,
 	)
 
@@ -269,6 +728,20 @@ func (r *HabitRepository) UpdateHabit(ctx context.Context, habit *domain.Habit)
 		&result.CreatedAt,
 		&result.UpdatedAt,
 		&result.CompletedAt,
+		&result.IntervalDays,
+		&result.AnchorDate,
+		&result.QuotaTarget,
+		&result.QuotaPeriod,
+		&result.RRule,
+		&result.RRuleStart,
+		&result.TargetValue,
+		&result.Unit,
+		&result.DailyCount,
+		&result.GraduationStreak,
+		&result.GraduationRate,
+		&result.GraduationWeeks,
+		&result.StartsOn,
+		&result.EndsOn,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update habit: %w", err)
@@ -277,6 +750,73 @@ func (r *HabitRepository) UpdateHabit(ctx context.Context, habit *domain.Habit)
 	return &result, nil
 }
 
+// UpdateStreak обновляет только стрик привычки, не затрагивая остальные поля,
+// которые могли измениться параллельно
+func (r *HabitRepository) UpdateStreak(ctx context.Context, habitID, current, best int, lastCompleted sql.NullTime) (*domain.Habit, error) {
+	query := `
+		UPDATE habits
+		SET current_streak = $1, best_streak = $2, last_completed_date = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
+			current_streak, best_streak, last_completed_date, last_checked_date,
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		current,
+		best,
+		lastCompleted,
+		habitID,
+	)
+
+	var result domain.Habit
+	err := row.Scan(
+		&result.ID,
+		&result.UserID,
+		&result.Name,
+		&result.Description,
+		&result.Goal,
+		&result.Frequency,
+		&result.WeeklyDays,
+		&result.MonthlyDays,
+		&result.CurrentStreak,
+		&result.BestStreak,
+		&result.LastCompletedDate,
+		&result.LastCheckedDate,
+		&result.IsActive,
+		&result.IsCompleted,
+		&result.CreatedAt,
+		&result.UpdatedAt,
+		&result.CompletedAt,
+		&result.IntervalDays,
+		&result.AnchorDate,
+		&result.QuotaTarget,
+		&result.QuotaPeriod,
+		&result.RRule,
+		&result.RRuleStart,
+		&result.TargetValue,
+		&result.Unit,
+		&result.DailyCount,
+		&result.GraduationStreak,
+		&result.GraduationRate,
+		&result.GraduationWeeks,
+		&result.StartsOn,
+		&result.EndsOn,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update habit streak: %w", err)
+	}
+
+	return &result, nil
+}
+
 // DeleteHabit удаляет привычку
 func (r *HabitRepository) DeleteHabit(ctx context.Context, id int) error {
 	query := "DELETE FROM habits WHERE id = $1"
@@ -292,7 +832,14 @@ func (r *HabitRepository) GetAllActiveHabits(ctx context.Context) ([]*domain.Hab
 	query := `
 		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
 			current_streak, best_streak, last_completed_date, last_checked_date,
-			is_active, is_completed, created_at, updated_at, completed_at
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
 		FROM habits
 		WHERE is_active = true
 		ORDER BY id ASC
@@ -325,6 +872,94 @@ func (r *HabitRepository) GetAllActiveHabits(ctx context.Context) ([]*domain.Hab
 			&habit.CreatedAt,
 			&habit.UpdatedAt,
 			&habit.CompletedAt,
+			&habit.IntervalDays,
+			&habit.AnchorDate,
+			&habit.QuotaTarget,
+			&habit.QuotaPeriod,
+			&habit.RRule,
+			&habit.RRuleStart,
+			&habit.TargetValue,
+			&habit.Unit,
+			&habit.DailyCount,
+			&habit.GraduationStreak,
+			&habit.GraduationRate,
+			&habit.GraduationWeeks,
+			&habit.StartsOn,
+			&habit.EndsOn,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit: %w", err)
+		}
+		habits = append(habits, &habit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating habits: %w", err)
+	}
+
+	return habits, nil
+}
+
+// GetActiveHabitsAfterID получает порцию активных привычек с ID больше afterID (keyset пагинация)
+func (r *HabitRepository) GetActiveHabitsAfterID(ctx context.Context, afterID, limit int) ([]*domain.Habit, error) {
+	query := `
+		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
+			current_streak, best_streak, last_completed_date, last_checked_date,
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
+		FROM habits
+		WHERE is_active = true AND id > $1
+		ORDER BY id ASC
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active habits after id: %w", err)
+	}
+	defer rows.Close()
+
+	var habits []*domain.Habit
+	for rows.Next() {
+		var habit domain.Habit
+		err := rows.Scan(
+			&habit.ID,
+			&habit.UserID,
+			&habit.Name,
+			&habit.Description,
+			&habit.Goal,
+			&habit.Frequency,
+			&habit.WeeklyDays,
+			&habit.MonthlyDays,
+			&habit.CurrentStreak,
+			&habit.BestStreak,
+			&habit.LastCompletedDate,
+			&habit.LastCheckedDate,
+			&habit.IsActive,
+			&habit.IsCompleted,
+			&habit.CreatedAt,
+			&habit.UpdatedAt,
+			&habit.CompletedAt,
+			&habit.IntervalDays,
+			&habit.AnchorDate,
+			&habit.QuotaTarget,
+			&habit.QuotaPeriod,
+			&habit.RRule,
+			&habit.RRuleStart,
+			&habit.TargetValue,
+			&habit.Unit,
+			&habit.DailyCount,
+			&habit.GraduationStreak,
+			&habit.GraduationRate,
+			&habit.GraduationWeeks,
+			&habit.StartsOn,
+			&habit.EndsOn,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit: %w", err)
@@ -344,7 +979,14 @@ func (r *HabitRepository) GetHabitByUserIDAndName(ctx context.Context, userID in
 	query := `
 		SELECT id, user_id, name, description, goal, frequency, weekly_days, monthly_days,
 			current_streak, best_streak, last_completed_date, last_checked_date,
-			is_active, is_completed, created_at, updated_at, completed_at
+			is_active, is_completed, created_at, updated_at, completed_at,
+			interval_days, anchor_date,
+			quota_target, quota_period,
+			rrule, rrule_start,
+			target_value, unit,
+			daily_count,
+			graduation_streak, graduation_rate, graduation_weeks,
+			starts_on, ends_on
 		FROM habits
 		WHERE user_id = $1 AND name = $2
 	`
@@ -370,6 +1012,20 @@ func (r *HabitRepository) GetHabitByUserIDAndName(ctx context.Context, userID in
 		&habit.CreatedAt,
 		&habit.UpdatedAt,
 		&habit.CompletedAt,
+		&habit.IntervalDays,
+		&habit.AnchorDate,
+		&habit.QuotaTarget,
+		&habit.QuotaPeriod,
+		&habit.RRule,
+		&habit.RRuleStart,
+		&habit.TargetValue,
+		&habit.Unit,
+		&habit.DailyCount,
+		&habit.GraduationStreak,
+		&habit.GraduationRate,
+		&habit.GraduationWeeks,
+		&habit.StartsOn,
+		&habit.EndsOn,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get habit by user_id and name: %w", err)
@@ -377,3 +1033,30 @@ func (r *HabitRepository) GetHabitByUserIDAndName(ctx context.Context, userID in
 
 	return &habit, nil
 }
+
+// UpdateLastCheckedDates обновляет last_checked_date у нескольких привычек одним запросом
+func (r *HabitRepository) UpdateLastCheckedDates(ctx context.Context, dates map[int]time.Time) error {
+	if len(dates) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE habits AS h
+		SET last_checked_date = d.checked_date, updated_at = $3
+		FROM unnest($1::int[], $2::date[]) AS d(id, checked_date)
+		WHERE h.id = d.id
+	`
+
+	ids := make([]int, 0, len(dates))
+	checked := make([]time.Time, 0, len(dates))
+	for id, date := range dates {
+		ids = append(ids, id)
+		checked = append(checked, date)
+	}
+
+	_, err := r.pool.Exec(ctx, query, ids, checked, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to update last_checked_dates: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// HabitEventRepository реализация интерфейса HabitEventRepository для PostgreSQL
+type HabitEventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewHabitEventRepository создает новый HabitEventRepository
+func NewHabitEventRepository(pool *pgxpool.Pool) *HabitEventRepository {
+	return &HabitEventRepository{pool: pool}
+}
+
+// GetUnpublishedEvents получает до limit неопубликованных событий типов types в порядке создания
+func (r *HabitEventRepository) GetUnpublishedEvents(ctx context.Context, types []domain.HabitEventType, limit int) ([]*domain.HabitEvent, error) {
+	query := `
+		SELECT id, habit_id, user_id, event_type, payload, created_at, published_at
+		FROM habit_events
+		WHERE published_at IS NULL AND event_type = ANY($1)
+		ORDER BY id
+		LIMIT $2
+	`
+
+	eventTypes := make([]string, len(types))
+	for i, t := range types {
+		eventTypes[i] = string(t)
+	}
+
+	rows, err := r.pool.Query(ctx, query, eventTypes, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unpublished events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*domain.HabitEvent
+	for rows.Next() {
+		var event domain.HabitEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.HabitID,
+			&event.UserID,
+			&event.Type,
+			&event.Payload,
+			&event.CreatedAt,
+			&event.PublishedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, &event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating events: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkEventPublished отмечает событие опубликованным
+func (r *HabitEventRepository) MarkEventPublished(ctx context.Context, id int) error {
+	query := `
+		UPDATE habit_events
+		SET published_at = NOW()
+		WHERE id = $1 AND published_at IS NULL
+	`
+
+	if _, err := r.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark event published: %w", err)
+	}
+
+	return nil
+}
@@ -23,9 +23,9 @@ func NewHabitLogRepository(pool *pgxpool.Pool) *HabitLogRepository {
 // CreateLog создает новый лог выполнения
 func (r *HabitLogRepository) CreateLog(ctx context.Context, log *domain.HabitLog) (*domain.HabitLog, error) {
 	query := `
-		INSERT INTO habit_logs (habit_id, user_id, comment, logged_date, logged_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, habit_id, user_id, comment, logged_date, logged_at
+		INSERT INTO habit_logs (habit_id, user_id, comment, logged_date, logged_at, value, completion_number)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, habit_id, user_id, comment, logged_date, logged_at, value, completion_number
 	`
 
 	row := r.pool.QueryRow(ctx, query,
@@ -34,6 +34,8 @@ func (r *HabitLogRepository) CreateLog(ctx context.Context, log *domain.HabitLog
 		log.Comment,
 		log.LoggedDate,
 		log.LoggedAt,
+		log.Value,
+		log.CompletionNumber,
 	)
 
 	var result domain.HabitLog
@@ -44,6 +46,8 @@ func (r *HabitLogRepository) CreateLog(ctx context.Context, log *domain.HabitLog
 		&result.Comment,
 		&result.LoggedDate,
 		&result.LoggedAt,
+		&result.Value,
+		&result.CompletionNumber,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create habit log: %w", err)
@@ -55,7 +59,7 @@ func (r *HabitLogRepository) CreateLog(ctx context.Context, log *domain.HabitLog
 // GetLogByID получает лог по ID
 func (r *HabitLogRepository) GetLogByID(ctx context.Context, id int) (*domain.HabitLog, error) {
 	query := `
-		SELECT id, habit_id, user_id, comment, logged_date, logged_at
+		SELECT id, habit_id, user_id, comment, logged_date, logged_at, value, completion_number
 		FROM habit_logs
 		WHERE id = $1
 	`
@@ -70,6 +74,8 @@ func (r *HabitLogRepository) GetLogByID(ctx context.Context, id int) (*domain.Ha
 		&log.Comment,
 		&log.LoggedDate,
 		&log.LoggedAt,
+		&log.Value,
+		&log.CompletionNumber,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get habit log by id: %w", err)
@@ -81,10 +87,10 @@ func (r *HabitLogRepository) GetLogByID(ctx context.Context, id int) (*domain.Ha
 // GetLogsByHabitID получает логи по привычке
 func (r *HabitLogRepository) GetLogsByHabitID(ctx context.Context, habitID int) ([]*domain.HabitLog, error) {
 	query := `
-		SELECT id, habit_id, user_id, comment, logged_date, logged_at
+		SELECT id, habit_id, user_id, comment, logged_date, logged_at, value, completion_number
 		FROM habit_logs
 		WHERE habit_id = $1
-		ORDER BY logged_date DESC
+		ORDER BY logged_date DESC, completion_number DESC
 	`
 
 	rows, err := r.pool.Query(ctx, query, habitID)
@@ -103,6 +109,8 @@ func (r *HabitLogRepository) GetLogsByHabitID(ctx context.Context, habitID int)
 			&log.Comment,
 			&log.LoggedDate,
 			&log.LoggedAt,
+			&log.Value,
+			&log.CompletionNumber,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit log: %w", err)
@@ -120,10 +128,10 @@ func (r *HabitLogRepository) GetLogsByHabitID(ctx context.Context, habitID int)
 // GetLogsByHabitIDAndDate получает логи за определенный период
 func (r *HabitLogRepository) GetLogsByHabitIDAndDate(ctx context.Context, habitID int, from, to time.Time) ([]*domain.HabitLog, error) {
 	query := `
-		SELECT id, habit_id, user_id, comment, logged_date, logged_at
+		SELECT id, habit_id, user_id, comment, logged_date, logged_at, value, completion_number
 		FROM habit_logs
 		WHERE habit_id = $1 AND logged_date >= $2 AND logged_date <= $3
-		ORDER BY logged_date DESC
+		ORDER BY logged_date DESC, completion_number DESC
 	`
 
 	rows, err := r.pool.Query(ctx, query, habitID, from, to)
@@ -142,6 +150,8 @@ func (r *HabitLogRepository) GetLogsByHabitIDAndDate(ctx context.Context, habitI
 			&log.Comment,
 			&log.LoggedDate,
 			&log.LoggedAt,
+			&log.Value,
+			&log.CompletionNumber,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan habit log: %w", err)
@@ -156,12 +166,14 @@ func (r *HabitLogRepository) GetLogsByHabitIDAndDate(ctx context.Context, habitI
 	return logs, nil
 }
 
-// GetLogByHabitIDAndDate получает лог за конкретный день
+// GetLogByHabitIDAndDate получает первый лог за конкретный день
 func (r *HabitLogRepository) GetLogByHabitIDAndDate(ctx context.Context, habitID int, date time.Time) (*domain.HabitLog, error) {
 	query := `
-		SELECT id, habit_id, user_id, comment, logged_date, logged_at
+		SELECT id, habit_id, user_id, comment, logged_date, logged_at, value, completion_number
 		FROM habit_logs
 		WHERE habit_id = $1 AND logged_date = $2
+		ORDER BY completion_number
+		LIMIT 1
 	`
 
 	row := r.pool.QueryRow(ctx, query, habitID, date)
@@ -174,6 +186,8 @@ func (r *HabitLogRepository) GetLogByHabitIDAndDate(ctx context.Context, habitID
 		&log.Comment,
 		&log.LoggedDate,
 		&log.LoggedAt,
+		&log.Value,
+		&log.CompletionNumber,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get log by habit_id and date: %w", err)
@@ -182,6 +196,35 @@ func (r *HabitLogRepository) GetLogByHabitIDAndDate(ctx context.Context, habitID
 	return &log, nil
 }
 
+// AddLogValue атомарно прибавляет value к значению лога; непустой comment заменяет комментарий
+func (r *HabitLogRepository) AddLogValue(ctx context.Context, id int, value float64, comment string) (*domain.HabitLog, error) {
+	query := `
+		UPDATE habit_logs
+		SET value = COALESCE(value, 0) + $1, comment = COALESCE(NULLIF($2, ''), comment), logged_at = NOW()
+		WHERE id = $3
+		RETURNING id, habit_id, user_id, comment, logged_date, logged_at, value, completion_number
+	`
+
+	row := r.pool.QueryRow(ctx, query, value, comment, id)
+
+	var result domain.HabitLog
+	err := row.Scan(
+		&result.ID,
+		&result.HabitID,
+		&result.UserID,
+		&result.Comment,
+		&result.LoggedDate,
+		&result.LoggedAt,
+		&result.Value,
+		&result.CompletionNumber,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add log value: %w", err)
+	}
+
+	return &result, nil
+}
+
 // DeleteLog удаляет лог
 func (r *HabitLogRepository) DeleteLog(ctx context.Context, id int) error {
 	query := "DELETE FROM habit_logs WHERE id = $1"
@@ -208,3 +251,44 @@ func (r *HabitLogRepository) CountLogsByHabitIDAndDate(ctx context.Context, habi
 
 	return count, nil
 }
+
+// GetLogsByHabitIDsAndDate получает логи нескольких привычек за период одним запросом
+func (r *HabitLogRepository) GetLogsByHabitIDsAndDate(ctx context.Context, habitIDs []int, from, to time.Time) ([]*domain.HabitLog, error) {
+	query := `
+		SELECT id, habit_id, user_id, comment, logged_date, logged_at, value, completion_number
+		FROM habit_logs
+		WHERE habit_id = ANY($1) AND logged_date >= $2 AND logged_date <= $3
+		ORDER BY habit_id ASC, logged_date ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitIDs, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs by habit_ids and date: %w", err)
+	}
+	defer rows.Close()
+
+	var logs []*domain.HabitLog
+	for rows.Next() {
+		var log domain.HabitLog
+		err := rows.Scan(
+			&log.ID,
+			&log.HabitID,
+			&log.UserID,
+			&log.Comment,
+			&log.LoggedDate,
+			&log.LoggedAt,
+			&log.Value,
+			&log.CompletionNumber,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit log: %w", err)
+		}
+		logs = append(logs, &log)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating logs: %w", err)
+	}
+
+	return logs, nil
+}
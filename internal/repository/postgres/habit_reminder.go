@@ -23,9 +23,9 @@ func NewHabitReminderRepository(pool *pgxpool.Pool) *HabitReminderRepository {
 // CreateReminder создает новое напоминание
 func (r *HabitReminderRepository) CreateReminder(ctx context.Context, reminder *domain.HabitReminder) (*domain.HabitReminder, error) {
 	query := `
-		INSERT INTO habit_reminders (habit_id, user_id, reminder_date, is_completed, sent_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, habit_id, user_id, reminder_date, is_completed, sent_at
+		INSERT INTO habit_reminders (habit_id, user_id, reminder_date, is_completed, sent_at, is_skipped)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, habit_id, user_id, reminder_date, is_completed, sent_at, is_skipped
 	`
 
 	row := r.pool.QueryRow(ctx, query,
@@ -34,6 +34,7 @@ func (r *HabitReminderRepository) CreateReminder(ctx context.Context, reminder *
 		reminder.ReminderDate,
 		reminder.IsCompleted,
 		reminder.SentAt,
+		reminder.IsSkipped,
 	)
 
 	var result domain.HabitReminder
@@ -44,6 +45,7 @@ func (r *HabitReminderRepository) CreateReminder(ctx context.Context, reminder *
 		&result.ReminderDate,
 		&result.IsCompleted,
 		&result.SentAt,
+		&result.IsSkipped,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create reminder: %w", err)
@@ -55,7 +57,7 @@ func (r *HabitReminderRepository) CreateReminder(ctx context.Context, reminder *
 // GetReminderByID получает напоминание по ID
 func (r *HabitReminderRepository) GetReminderByID(ctx context.Context, id int) (*domain.HabitReminder, error) {
 	query := `
-		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at
+		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at, is_skipped
 		FROM habit_reminders
 		WHERE id = $1
 	`
@@ -70,6 +72,7 @@ func (r *HabitReminderRepository) GetReminderByID(ctx context.Context, id int) (
 		&reminder.ReminderDate,
 		&reminder.IsCompleted,
 		&reminder.SentAt,
+		&reminder.IsSkipped,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reminder by id: %w", err)
@@ -81,7 +84,7 @@ func (r *HabitReminderRepository) GetReminderByID(ctx context.Context, id int) (
 // GetRemindersByUserID получает напоминания пользователя
 func (r *HabitReminderRepository) GetRemindersByUserID(ctx context.Context, userID int) ([]*domain.HabitReminder, error) {
 	query := `
-		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at
+		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at, is_skipped
 		FROM habit_reminders
 		WHERE user_id = $1
 		ORDER BY reminder_date DESC
@@ -103,6 +106,7 @@ func (r *HabitReminderRepository) GetRemindersByUserID(ctx context.Context, user
 			&reminder.ReminderDate,
 			&reminder.IsCompleted,
 			&reminder.SentAt,
+			&reminder.IsSkipped,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan reminder: %w", err)
@@ -120,7 +124,7 @@ func (r *HabitReminderRepository) GetRemindersByUserID(ctx context.Context, user
 // GetRemindersByDate получает напоминания на дату
 func (r *HabitReminderRepository) GetRemindersByDate(ctx context.Context, date time.Time) ([]*domain.HabitReminder, error) {
 	query := `
-		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at
+		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at, is_skipped
 		FROM habit_reminders
 		WHERE reminder_date = $1
 		ORDER BY sent_at DESC
@@ -142,6 +146,7 @@ func (r *HabitReminderRepository) GetRemindersByDate(ctx context.Context, date t
 			&reminder.ReminderDate,
 			&reminder.IsCompleted,
 			&reminder.SentAt,
+			&reminder.IsSkipped,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan reminder: %w", err)
@@ -159,7 +164,7 @@ func (r *HabitReminderRepository) GetRemindersByDate(ctx context.Context, date t
 // GetRemindersByUserIDAndDate получает напоминания пользователя на дату
 func (r *HabitReminderRepository) GetRemindersByUserIDAndDate(ctx context.Context, userID int, date time.Time) ([]*domain.HabitReminder, error) {
 	query := `
-		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at
+		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at, is_skipped
 		FROM habit_reminders
 		WHERE user_id = $1 AND reminder_date = $2
 		ORDER BY sent_at DESC
@@ -181,6 +186,7 @@ func (r *HabitReminderRepository) GetRemindersByUserIDAndDate(ctx context.Contex
 			&reminder.ReminderDate,
 			&reminder.IsCompleted,
 			&reminder.SentAt,
+			&reminder.IsSkipped,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan reminder: %w", err)
@@ -198,7 +204,7 @@ func (r *HabitReminderRepository) GetRemindersByUserIDAndDate(ctx context.Contex
 // GetReminderByHabitIDAndDate получает напоминание по привычке и дате
 func (r *HabitReminderRepository) GetReminderByHabitIDAndDate(ctx context.Context, habitID int, date time.Time) (*domain.HabitReminder, error) {
 	query := `
-		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at
+		SELECT id, habit_id, user_id, reminder_date, is_completed, sent_at, is_skipped
 		FROM habit_reminders
 		WHERE habit_id = $1 AND reminder_date = $2
 	`
@@ -213,6 +219,7 @@ func (r *HabitReminderRepository) GetReminderByHabitIDAndDate(ctx context.Contex
 		&reminder.ReminderDate,
 		&reminder.IsCompleted,
 		&reminder.SentAt,
+		&reminder.IsSkipped,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reminder by habit_id and date: %w", err)
@@ -225,13 +232,14 @@ func (r *HabitReminderRepository) GetReminderByHabitIDAndDate(ctx context.Contex
 func (r *HabitReminderRepository) UpdateReminder(ctx context.Context, reminder *domain.HabitReminder) (*domain.HabitReminder, error) {
 	query := `
 		UPDATE habit_reminders
-		SET is_completed = $1
-		WHERE id = $2
-		RETURNING id, habit_id, user_id, reminder_date, is_completed, sent_at
+		SET is_completed = $1, is_skipped = $2
+		WHERE id = $3
+		RETURNING id, habit_id, user_id, reminder_date, is_completed, sent_at, is_skipped
 	`
 
 	row := r.pool.QueryRow(ctx, query,
 		reminder.IsCompleted,
+		reminder.IsSkipped,
 		reminder.ID,
 	)
 
@@ -243,6 +251,7 @@ func (r *HabitReminderRepository) UpdateReminder(ctx context.Context, reminder *
 		&result.ReminderDate,
 		&result.IsCompleted,
 		&result.SentAt,
+		&result.IsSkipped,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update reminder: %w", err)
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// JobRunRepository реализация интерфейса JobRunRepository для PostgreSQL
+type JobRunRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewJobRunRepository создает новый JobRunRepository
+func NewJobRunRepository(pool *pgxpool.Pool) *JobRunRepository {
+	return &JobRunRepository{pool: pool}
+}
+
+// CreateJobRun создает запись о запуске задачи
+func (r *JobRunRepository) CreateJobRun(ctx context.Context, run *domain.JobRun) (*domain.JobRun, error) {
+	query := `
+		INSERT INTO scheduler_job_runs (
+			job_name, trigger, status, started_at, finished_at,
+			users_processed, habits_processed, failed_count, error
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, job_name, trigger, status, started_at, finished_at,
+			users_processed, habits_processed, failed_count, error
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		run.JobName,
+		run.Trigger,
+		run.Status,
+		run.StartedAt,
+		run.FinishedAt,
+		run.UsersProcessed,
+		run.HabitsProcessed,
+		run.FailedCount,
+		run.Error,
+	)
+
+	var result domain.JobRun
+	err := row.Scan(
+		&result.ID,
+		&result.JobName,
+		&result.Trigger,
+		&result.Status,
+		&result.StartedAt,
+		&result.FinishedAt,
+		&result.UsersProcessed,
+		&result.HabitsProcessed,
+		&result.FailedCount,
+		&result.Error,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job run: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetJobRunByID получает запуск по ID
+func (r *JobRunRepository) GetJobRunByID(ctx context.Context, id int) (*domain.JobRun, error) {
+	query := `
+		SELECT id, job_name, trigger, status, started_at, finished_at,
+			users_processed, habits_processed, failed_count, error
+		FROM scheduler_job_runs
+		WHERE id = $1
+	`
+
+	row := r.pool.QueryRow(ctx, query, id)
+
+	var run domain.JobRun
+	err := row.Scan(
+		&run.ID,
+		&run.JobName,
+		&run.Trigger,
+		&run.Status,
+		&run.StartedAt,
+		&run.FinishedAt,
+		&run.UsersProcessed,
+		&run.HabitsProcessed,
+		&run.FailedCount,
+		&run.Error,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job run by id: %w", err)
+	}
+
+	return &run, nil
+}
+
+// GetJobRuns получает последние запуски, jobName пустой - по всем задачам
+func (r *JobRunRepository) GetJobRuns(ctx context.Context, jobName string, limit int) ([]*domain.JobRun, error) {
+	query := `
+		SELECT id, job_name, trigger, status, started_at, finished_at,
+			users_processed, habits_processed, failed_count, error
+		FROM scheduler_job_runs
+		WHERE $1 = '' OR job_name = $1
+		ORDER BY started_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, jobName, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*domain.JobRun
+	for rows.Next() {
+		var run domain.JobRun
+		err := rows.Scan(
+			&run.ID,
+			&run.JobName,
+			&run.Trigger,
+			&run.Status,
+			&run.StartedAt,
+			&run.FinishedAt,
+			&run.UsersProcessed,
+			&run.HabitsProcessed,
+			&run.FailedCount,
+			&run.Error,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job run: %w", err)
+		}
+		runs = append(runs, &run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating job runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+// UpdateJobRun обновляет запись о запуске
+func (r *JobRunRepository) UpdateJobRun(ctx context.Context, run *domain.JobRun) (*domain.JobRun, error) {
+	query := `
+		UPDATE scheduler_job_runs
+		SET status = $1, finished_at = $2, users_processed = $3,
+			habits_processed = $4, failed_count = $5, error = $6
+		WHERE id = $7
+		RETURNING id, job_name, trigger, status, started_at, finished_at,
+			users_processed, habits_processed, failed_count, error
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		run.Status,
+		run.FinishedAt,
+		run.UsersProcessed,
+		run.HabitsProcessed,
+		run.FailedCount,
+		run.Error,
+		run.ID,
+	)
+
+	var result domain.JobRun
+	err := row.Scan(
+		&result.ID,
+		&result.JobName,
+		&result.Trigger,
+		&result.Status,
+		&result.StartedAt,
+		&result.FinishedAt,
+		&result.UsersProcessed,
+		&result.HabitsProcessed,
+		&result.FailedCount,
+		&result.Error,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update job run: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// PauseRepository реализация интерфейса PauseRepository для PostgreSQL
+type PauseRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPauseRepository создает новый PauseRepository
+func NewPauseRepository(pool *pgxpool.Pool) *PauseRepository {
+	return &PauseRepository{pool: pool}
+}
+
+// CreatePause создает паузу
+func (r *PauseRepository) CreatePause(ctx context.Context, pause *domain.Pause) (*domain.Pause, error) {
+	query := `
+		INSERT INTO habit_pauses (user_id, habit_id, start_date, end_date, reason, created_at, cancelled_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, user_id, habit_id, start_date, end_date, reason, created_at, cancelled_at
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		pause.UserID,
+		pause.HabitID,
+		pause.StartDate,
+		pause.EndDate,
+		pause.Reason,
+		pause.CreatedAt,
+		pause.CancelledAt,
+	)
+
+	var result domain.Pause
+	err := row.Scan(
+		&result.ID,
+		&result.UserID,
+		&result.HabitID,
+		&result.StartDate,
+		&result.EndDate,
+		&result.Reason,
+		&result.CreatedAt,
+		&result.CancelledAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pause: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetPauseByID получает паузу по ID
+func (r *PauseRepository) GetPauseByID(ctx context.Context, id int) (*domain.Pause, error) {
+	query := `
+		SELECT id, user_id, habit_id, start_date, end_date, reason, created_at, cancelled_at
+		FROM habit_pauses
+		WHERE id = $1
+	`
+
+	row := r.pool.QueryRow(ctx, query, id)
+
+	var pause domain.Pause
+	err := row.Scan(
+		&pause.ID,
+		&pause.UserID,
+		&pause.HabitID,
+		&pause.StartDate,
+		&pause.EndDate,
+		&pause.Reason,
+		&pause.CreatedAt,
+		&pause.CancelledAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pause by id: %w", err)
+	}
+
+	return &pause, nil
+}
+
+// GetPausesByUserID получает все паузы пользователя, включая отмененные
+func (r *PauseRepository) GetPausesByUserID(ctx context.Context, userID int) ([]*domain.Pause, error) {
+	query := `
+		SELECT id, user_id, habit_id, start_date, end_date, reason, created_at, cancelled_at
+		FROM habit_pauses
+		WHERE user_id = $1
+		ORDER BY start_date DESC, id DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pauses by user id: %w", err)
+	}
+
+	return collectPauses(rows)
+}
+
+// GetPausesByUserIDs получает паузы пользователей, пересекающиеся с периодом [from, to]
+func (r *PauseRepository) GetPausesByUserIDs(ctx context.Context, userIDs []int, from, to time.Time) ([]*domain.Pause, error) {
+	query := `
+		SELECT id, user_id, habit_id, start_date, end_date, reason, created_at, cancelled_at
+		FROM habit_pauses
+		WHERE user_id = ANY($1) AND start_date <= $3 AND end_date >= $2 AND end_date >= start_date
+	`
+
+	rows, err := r.pool.Query(ctx, query, userIDs, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pauses by user ids: %w", err)
+	}
+
+	return collectPauses(rows)
+}
+
+// UpdatePause обновляет паузу
+func (r *PauseRepository) UpdatePause(ctx context.Context, pause *domain.Pause) (*domain.Pause, error) {
+	query := `
+		UPDATE habit_pauses
+		SET start_date = $1, end_date = $2, reason = $3, cancelled_at = $4
+		WHERE id = $5
+		RETURNING id, user_id, habit_id, start_date, end_date, reason, created_at, cancelled_at
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		pause.StartDate,
+		pause.EndDate,
+		pause.Reason,
+		pause.CancelledAt,
+		pause.ID,
+	)
+
+	var result domain.Pause
+	err := row.Scan(
+		&result.ID,
+		&result.UserID,
+		&result.HabitID,
+		&result.StartDate,
+		&result.EndDate,
+		&result.Reason,
+		&result.CreatedAt,
+		&result.CancelledAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pause: %w", err)
+	}
+
+	return &result, nil
+}
+
+// collectPauses читает все паузы из результата запроса и закрывает его
+func collectPauses(rows pgx.Rows) ([]*domain.Pause, error) {
+	defer rows.Close()
+
+	var pauses []*domain.Pause
+	for rows.Next() {
+		var pause domain.Pause
+		err := rows.Scan(
+			&pause.ID,
+			&pause.UserID,
+			&pause.HabitID,
+			&pause.StartDate,
+			&pause.EndDate,
+			&pause.Reason,
+			&pause.CreatedAt,
+			&pause.CancelledAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pause: %w", err)
+		}
+		pauses = append(pauses, &pause)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating pauses: %w", err)
+	}
+
+	return pauses, nil
+}
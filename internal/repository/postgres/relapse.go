@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// RelapseRepository реализация интерфейса RelapseRepository для PostgreSQL
+type RelapseRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRelapseRepository создает новый RelapseRepository
+func NewRelapseRepository(pool *pgxpool.Pool) *RelapseRepository {
+	return &RelapseRepository{pool: pool}
+}
+
+// CreateRelapse создает запись о срыве
+func (r *RelapseRepository) CreateRelapse(ctx context.Context, relapse *domain.Relapse) (*domain.Relapse, error) {
+	query := `
+		INSERT INTO habit_relapses (habit_id, user_id, relapse_date, note, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, habit_id, user_id, relapse_date, note, created_at
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		relapse.HabitID,
+		relapse.UserID,
+		relapse.RelapseDate,
+		relapse.Note,
+		relapse.CreatedAt,
+	)
+
+	var result domain.Relapse
+	err := row.Scan(
+		&result.ID,
+		&result.HabitID,
+		&result.UserID,
+		&result.RelapseDate,
+		&result.Note,
+		&result.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create relapse: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetRelapsesByHabitID получает все срывы привычки, последние первыми
+func (r *RelapseRepository) GetRelapsesByHabitID(ctx context.Context, habitID int) ([]*domain.Relapse, error) {
+	query := `
+		SELECT id, habit_id, user_id, relapse_date, note, created_at
+		FROM habit_relapses
+		WHERE habit_id = $1
+		ORDER BY relapse_date DESC, id DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relapses: %w", err)
+	}
+	defer rows.Close()
+
+	var relapses []*domain.Relapse
+	for rows.Next() {
+		var relapse domain.Relapse
+		err := rows.Scan(
+			&relapse.ID,
+			&relapse.HabitID,
+			&relapse.UserID,
+			&relapse.RelapseDate,
+			&relapse.Note,
+			&relapse.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan relapse: %w", err)
+		}
+		relapses = append(relapses, &relapse)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating relapses: %w", err)
+	}
+
+	return relapses, nil
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// ScheduleRevisionRepository реализация интерфейса ScheduleRevisionRepository для PostgreSQL
+type ScheduleRevisionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewScheduleRevisionRepository создает новый ScheduleRevisionRepository
+func NewScheduleRevisionRepository(pool *pgxpool.Pool) *ScheduleRevisionRepository {
+	return &ScheduleRevisionRepository{pool: pool}
+}
+
+// CreateScheduleRevision сохраняет редакцию; повторная редакция с той же даты заменяет предыдущую
+func (r *ScheduleRevisionRepository) CreateScheduleRevision(ctx context.Context, revision *domain.ScheduleRevision) (*domain.ScheduleRevision, error) {
+	query := `
+		INSERT INTO habit_schedule_revisions (
+			habit_id, effective_from, frequency, weekly_days, monthly_days,
+			interval_days, anchor_date, quota_target, quota_period, rrule, rrule_start, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (habit_id, effective_from) DO UPDATE
+		SET frequency = EXCLUDED.frequency, weekly_days = EXCLUDED.weekly_days,
+			monthly_days = EXCLUDED.monthly_days, interval_days = EXCLUDED.interval_days,
+			anchor_date = EXCLUDED.anchor_date, quota_target = EXCLUDED.quota_target,
+			quota_period = EXCLUDED.quota_period, rrule = EXCLUDED.rrule,
+			rrule_start = EXCLUDED.rrule_start, created_at = EXCLUDED.created_at
+		RETURNING id, habit_id, effective_from, frequency, weekly_days, monthly_days,
+			interval_days, anchor_date, quota_target, quota_period, rrule, rrule_start, created_at
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		revision.HabitID,
+		revision.EffectiveFrom,
+		revision.Frequency,
+		revision.WeeklyDays,
+		revision.MonthlyDays,
+		revision.IntervalDays,
+		revision.AnchorDate,
+		revision.QuotaTarget,
+		revision.QuotaPeriod,
+		revision.RRule,
+		revision.RRuleStart,
+		revision.CreatedAt,
+	)
+
+	var result domain.ScheduleRevision
+	err := row.Scan(
+		&result.ID,
+		&result.HabitID,
+		&result.EffectiveFrom,
+		&result.Frequency,
+		&result.WeeklyDays,
+		&result.MonthlyDays,
+		&result.IntervalDays,
+		&result.AnchorDate,
+		&result.QuotaTarget,
+		&result.QuotaPeriod,
+		&result.RRule,
+		&result.RRuleStart,
+		&result.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule revision: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetScheduleRevisionsByHabitIDs получает все редакции расписания привычек
+func (r *ScheduleRevisionRepository) GetScheduleRevisionsByHabitIDs(ctx context.Context, habitIDs []int) ([]*domain.ScheduleRevision, error) {
+	query := `
+		SELECT id, habit_id, effective_from, frequency, weekly_days, monthly_days,
+			interval_days, anchor_date, quota_target, quota_period, rrule, rrule_start, created_at
+		FROM habit_schedule_revisions
+		WHERE habit_id = ANY($1)
+		ORDER BY habit_id ASC, effective_from ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.ScheduleRevision
+	for rows.Next() {
+		var revision domain.ScheduleRevision
+		err := rows.Scan(
+			&revision.ID,
+			&revision.HabitID,
+			&revision.EffectiveFrom,
+			&revision.Frequency,
+			&revision.WeeklyDays,
+			&revision.MonthlyDays,
+			&revision.IntervalDays,
+			&revision.AnchorDate,
+			&revision.QuotaTarget,
+			&revision.QuotaPeriod,
+			&revision.RRule,
+			&revision.RRuleStart,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule revision: %w", err)
+		}
+		revisions = append(revisions, &revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating schedule revisions: %w", err)
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// SchedulerRunRepository реализация интерфейса SchedulerRunRepository для PostgreSQL
+type SchedulerRunRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSchedulerRunRepository создает новый SchedulerRunRepository
+func NewSchedulerRunRepository(pool *pgxpool.Pool) *SchedulerRunRepository {
+	return &SchedulerRunRepository{pool: pool}
+}
+
+// GetSchedulerRuns получает последние успешные запуски всех задач
+func (r *SchedulerRunRepository) GetSchedulerRuns(ctx context.Context) ([]*domain.SchedulerRun, error) {
+	query := `
+		SELECT job_name, last_success_at, updated_at
+		FROM scheduler_runs
+		ORDER BY job_name ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduler runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*domain.SchedulerRun
+	for rows.Next() {
+		var run domain.SchedulerRun
+		err := rows.Scan(
+			&run.JobName,
+			&run.LastSuccessAt,
+			&run.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduler run: %w", err)
+		}
+		runs = append(runs, &run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating scheduler runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+// SaveSchedulerRun сохраняет последний успешный запуск задачи.
+// Время запуска только увеличивается, чтобы опоздавший запуск не откатил его назад.
+func (r *SchedulerRunRepository) SaveSchedulerRun(ctx context.Context, run *domain.SchedulerRun) (*domain.SchedulerRun, error) {
+	query := `
+		INSERT INTO scheduler_runs (job_name, last_success_at, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (job_name) DO UPDATE
+		SET last_success_at = GREATEST(scheduler_runs.last_success_at, EXCLUDED.last_success_at),
+			updated_at = EXCLUDED.updated_at
+		RETURNING job_name, last_success_at, updated_at
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		run.JobName,
+		run.LastSuccessAt,
+		run.UpdatedAt,
+	)
+
+	var result domain.SchedulerRun
+	err := row.Scan(
+		&result.JobName,
+		&result.LastSuccessAt,
+		&result.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save scheduler run: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// SkipRepository реализация интерфейса SkipRepository для PostgreSQL
+type SkipRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSkipRepository создает новый SkipRepository
+func NewSkipRepository(pool *pgxpool.Pool) *SkipRepository {
+	return &SkipRepository{pool: pool}
+}
+
+// CreateSkip создает пропуск дня; повторный пропуск того же дня обновляет причину
+func (r *SkipRepository) CreateSkip(ctx context.Context, skip *domain.Skip) (*domain.Skip, error) {
+	query := `
+		INSERT INTO habit_skips (habit_id, user_id, skip_date, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (habit_id, skip_date) DO UPDATE
+		SET reason = EXCLUDED.reason
+		RETURNING id, habit_id, user_id, skip_date, reason, created_at
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		skip.HabitID,
+		skip.UserID,
+		skip.SkipDate,
+		skip.Reason,
+		skip.CreatedAt,
+	)
+
+	var result domain.Skip
+	err := row.Scan(
+		&result.ID,
+		&result.HabitID,
+		&result.UserID,
+		&result.SkipDate,
+		&result.Reason,
+		&result.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create skip: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetSkipsByHabitIDs получает пропуски привычек за период [from, to]
+func (r *SkipRepository) GetSkipsByHabitIDs(ctx context.Context, habitIDs []int, from, to time.Time) ([]*domain.Skip, error) {
+	query := `
+		SELECT id, habit_id, user_id, skip_date, reason, created_at
+		FROM habit_skips
+		WHERE habit_id = ANY($1) AND skip_date >= $2 AND skip_date <= $3
+		ORDER BY habit_id ASC, skip_date ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitIDs, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skips: %w", err)
+	}
+	defer rows.Close()
+
+	var skips []*domain.Skip
+	for rows.Next() {
+		var skip domain.Skip
+		err := rows.Scan(
+			&skip.ID,
+			&skip.HabitID,
+			&skip.UserID,
+			&skip.SkipDate,
+			&skip.Reason,
+			&skip.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan skip: %w", err)
+		}
+		skips = append(skips, &skip)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating skips: %w", err)
+	}
+
+	return skips, nil
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// StreakFreezeRepository реализация интерфейса StreakFreezeRepository для PostgreSQL
+type StreakFreezeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewStreakFreezeRepository создает новый StreakFreezeRepository
+func NewStreakFreezeRepository(pool *pgxpool.Pool) *StreakFreezeRepository {
+	return &StreakFreezeRepository{pool: pool}
+}
+
+// GetBalance получает баланс заморозок пользователя; без записи баланс нулевой
+func (r *StreakFreezeRepository) GetBalance(ctx context.Context, userID int) (*domain.StreakFreezeBalance, error) {
+	query := `
+		SELECT user_id, tokens, updated_at
+		FROM streak_freeze_balances
+		WHERE user_id = $1
+	`
+
+	var balance domain.StreakFreezeBalance
+	err := r.pool.QueryRow(ctx, query, userID).Scan(
+		&balance.UserID,
+		&balance.Tokens,
+		&balance.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &domain.StreakFreezeBalance{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get freeze balance: %w", err)
+	}
+
+	return &balance, nil
+}
+
+// AddTokens добавляет пользователю amount заморозок
+func (r *StreakFreezeRepository) AddTokens(ctx context.Context, userID, amount int) (*domain.StreakFreezeBalance, error) {
+	query := `
+		INSERT INTO streak_freeze_balances (user_id, tokens, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET tokens = streak_freeze_balances.tokens + EXCLUDED.tokens, updated_at = NOW()
+		RETURNING user_id, tokens, updated_at
+	`
+
+	var balance domain.StreakFreezeBalance
+	err := r.pool.QueryRow(ctx, query, userID, amount).Scan(
+		&balance.UserID,
+		&balance.Tokens,
+		&balance.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add freeze tokens: %w", err)
+	}
+
+	return &balance, nil
+}
+
+// EarnToken начисляет заморозку за рубеж milestone стрика привычки, начатого в streakStart.
+// Каждый рубеж начисляется один раз, баланс не превышает maxTokens. Возвращает true, если рубеж новый.
+func (r *StreakFreezeRepository) EarnToken(ctx context.Context, userID, habitID int, streakStart time.Time, milestone, maxTokens int) (bool, error) {
+	query := `
+		WITH earned AS (
+			INSERT INTO streak_freeze_earnings (user_id, habit_id, streak_start, milestone)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (habit_id, streak_start, milestone) DO NOTHING
+			RETURNING user_id
+		)
+		INSERT INTO streak_freeze_balances (user_id, tokens, updated_at)
+		SELECT user_id, LEAST(1, $5), NOW() FROM earned
+		ON CONFLICT (user_id) DO UPDATE
+		SET tokens = GREATEST(streak_freeze_balances.tokens, LEAST(streak_freeze_balances.tokens + 1, $5)),
+			updated_at = NOW()
+		RETURNING user_id
+	`
+
+	var id int
+	err := r.pool.QueryRow(ctx, query, userID, habitID, streakStart, milestone, maxTokens).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to earn freeze token: %w", err)
+	}
+
+	return true, nil
+}
+
+// ConsumeToken тратит заморозку пользователя на пропуск entryID и отмечает запись очереди замороженной.
+// Возвращает время заморозки или невалидное время, если заморозок нет или запись уже заморожена.
+func (r *StreakFreezeRepository) ConsumeToken(ctx context.Context, userID, entryID int) (time.Time, bool, error) {
+	// Запись и баланс меняются одним запросом. Строка очереди блокируется первой, поэтому
+	// повторная заморозка той же записи ждет и ничего не тратит; при гонке за последнюю
+	// заморозку баланс не обновится, запись не будет заморожена и вернется false
+	query := `
+		WITH entry AS (
+			SELECT id FROM streak_reset_queue
+			WHERE id = $2 AND frozen_at IS NULL
+			FOR UPDATE
+		), spent AS (
+			UPDATE streak_freeze_balances
+			SET tokens = tokens - 1, updated_at = NOW()
+			WHERE user_id = $1 AND tokens > 0 AND EXISTS (SELECT 1 FROM entry)
+			RETURNING user_id
+		), frozen AS (
+			UPDATE streak_reset_queue
+			SET frozen_at = NOW()
+			WHERE id IN (SELECT id FROM entry) AND EXISTS (SELECT 1 FROM spent)
+			RETURNING frozen_at
+		)
+		SELECT frozen_at FROM frozen
+	`
+
+	var frozenAt time.Time
+	err := r.pool.QueryRow(ctx, query, userID, entryID).Scan(&frozenAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to consume freeze token: %w", err)
+	}
+
+	return frozenAt, true, nil
+}
@@ -25,7 +25,8 @@ func (r *StreakResetQueueRepository) CreateQueueEntry(ctx context.Context, entry
 	query := `
 		INSERT INTO streak_reset_queue (habit_id, user_id, reset_date, processed, created_at)
 		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at
+		RETURNING id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
 	`
 
 	row := r.pool.QueryRow(ctx, query,
@@ -46,6 +47,12 @@ func (r *StreakResetQueueRepository) CreateQueueEntry(ctx context.Context, entry
 		&result.ProcessedAt,
 		&result.PreviousStreak,
 		&result.CreatedAt,
+		&result.Attempts,
+		&result.LastError,
+		&result.NextAttemptAt,
+		&result.LockedUntil,
+		&result.DeadLetteredAt,
+		&result.FrozenAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create queue entry: %w", err)
@@ -57,7 +64,8 @@ func (r *StreakResetQueueRepository) CreateQueueEntry(ctx context.Context, entry
 // GetQueueEntryByID получает запись по ID
 func (r *StreakResetQueueRepository) GetQueueEntryByID(ctx context.Context, id int) (*domain.StreakResetQueue, error) {
 	query := `
-		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at
+		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
 		FROM streak_reset_queue
 		WHERE id = $1
 	`
@@ -74,6 +82,12 @@ func (r *StreakResetQueueRepository) GetQueueEntryByID(ctx context.Context, id i
 		&entry.ProcessedAt,
 		&entry.PreviousStreak,
 		&entry.CreatedAt,
+		&entry.Attempts,
+		&entry.LastError,
+		&entry.NextAttemptAt,
+		&entry.LockedUntil,
+		&entry.DeadLetteredAt,
+		&entry.FrozenAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get queue entry by id: %w", err)
@@ -85,9 +99,10 @@ func (r *StreakResetQueueRepository) GetQueueEntryByID(ctx context.Context, id i
 // GetUnprocessedEntries получает необработанные записи
 func (r *StreakResetQueueRepository) GetUnprocessedEntries(ctx context.Context) ([]*domain.StreakResetQueue, error) {
 	query := `
-		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at
+		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
 		FROM streak_reset_queue
-		WHERE processed = false
+		WHERE processed = false AND dead_lettered_at IS NULL
 		ORDER BY created_at ASC
 	`
 
@@ -109,6 +124,12 @@ func (r *StreakResetQueueRepository) GetUnprocessedEntries(ctx context.Context)
 			&entry.ProcessedAt,
 			&entry.PreviousStreak,
 			&entry.CreatedAt,
+			&entry.Attempts,
+			&entry.LastError,
+			&entry.NextAttemptAt,
+			&entry.LockedUntil,
+			&entry.DeadLetteredAt,
+			&entry.FrozenAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan queue entry: %w", err)
@@ -126,9 +147,10 @@ func (r *StreakResetQueueRepository) GetUnprocessedEntries(ctx context.Context)
 // GetUnprocessedEntriesByDate получает необработанные записи на дату
 func (r *StreakResetQueueRepository) GetUnprocessedEntriesByDate(ctx context.Context, date time.Time) ([]*domain.StreakResetQueue, error) {
 	query := `
-		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at
+		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
 		FROM streak_reset_queue
-		WHERE processed = false AND reset_date = $1
+		WHERE processed = false AND dead_lettered_at IS NULL AND reset_date = $1
 		ORDER BY created_at ASC
 	`
 
@@ -150,6 +172,12 @@ func (r *StreakResetQueueRepository) GetUnprocessedEntriesByDate(ctx context.Con
 			&entry.ProcessedAt,
 			&entry.PreviousStreak,
 			&entry.CreatedAt,
+			&entry.Attempts,
+			&entry.LastError,
+			&entry.NextAttemptAt,
+			&entry.LockedUntil,
+			&entry.DeadLetteredAt,
+			&entry.FrozenAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan queue entry: %w", err)
@@ -168,15 +196,24 @@ func (r *StreakResetQueueRepository) GetUnprocessedEntriesByDate(ctx context.Con
 func (r *StreakResetQueueRepository) UpdateQueueEntry(ctx context.Context, entry *domain.StreakResetQueue) (*domain.StreakResetQueue, error) {
 	query := `
 		UPDATE streak_reset_queue
-		SET processed = $1, processed_at = $2, previous_streak = $3
-		WHERE id = $4
-		RETURNING id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at
+		SET processed = $1, processed_at = $2, previous_streak = $3,
+			attempts = $4, last_error = $5, next_attempt_at = $6, locked_until = $7, dead_lettered_at = $8,
+			frozen_at = $9
+		WHERE id = $10
+		RETURNING id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
 	`
 
 	row := r.pool.QueryRow(ctx, query,
 		entry.Processed,
 		entry.ProcessedAt,
 		entry.PreviousStreak,
+		entry.Attempts,
+		entry.LastError,
+		entry.NextAttemptAt,
+		entry.LockedUntil,
+		entry.DeadLetteredAt,
+		entry.FrozenAt,
 		entry.ID,
 	)
 
@@ -190,6 +227,12 @@ func (r *StreakResetQueueRepository) UpdateQueueEntry(ctx context.Context, entry
 		&result.ProcessedAt,
 		&result.PreviousStreak,
 		&result.CreatedAt,
+		&result.Attempts,
+		&result.LastError,
+		&result.NextAttemptAt,
+		&result.LockedUntil,
+		&result.DeadLetteredAt,
+		&result.FrozenAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update queue entry: %w", err)
@@ -211,7 +254,8 @@ func (r *StreakResetQueueRepository) DeleteQueueEntry(ctx context.Context, id in
 // GetQueueEntryByHabitIDAndDate получает запись по привычке и дате
 func (r *StreakResetQueueRepository) GetQueueEntryByHabitIDAndDate(ctx context.Context, habitID int, date time.Time) (*domain.StreakResetQueue, error) {
 	query := `
-		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at
+		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
 		FROM streak_reset_queue
 		WHERE habit_id = $1 AND reset_date = $2
 	`
@@ -228,6 +272,12 @@ func (r *StreakResetQueueRepository) GetQueueEntryByHabitIDAndDate(ctx context.C
 		&entry.ProcessedAt,
 		&entry.PreviousStreak,
 		&entry.CreatedAt,
+		&entry.Attempts,
+		&entry.LastError,
+		&entry.NextAttemptAt,
+		&entry.LockedUntil,
+		&entry.DeadLetteredAt,
+		&entry.FrozenAt,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get queue entry by habit_id and date: %w", err)
@@ -235,3 +285,225 @@ func (r *StreakResetQueueRepository) GetQueueEntryByHabitIDAndDate(ctx context.C
 
 	return &entry, nil
 }
+
+// CreateQueueEntries добавляет записи в очередь одним запросом.
+// Записи на уже стоящие в очереди (habit_id, reset_date) пропускаются.
+func (r *StreakResetQueueRepository) CreateQueueEntries(ctx context.Context, entries []*domain.StreakResetQueue) (int, error) {
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	query := `
+		INSERT INTO streak_reset_queue (habit_id, user_id, reset_date, processed, created_at)
+		SELECT habit_id, user_id, reset_date, false, created_at
+		FROM unnest($1::int[], $2::int[], $3::date[], $4::timestamp[])
+			AS e(habit_id, user_id, reset_date, created_at)
+		ON CONFLICT (habit_id, reset_date) DO NOTHING
+	`
+
+	habitIDs := make([]int, len(entries))
+	userIDs := make([]int, len(entries))
+	resetDates := make([]time.Time, len(entries))
+	createdAt := make([]time.Time, len(entries))
+	for i, entry := range entries {
+		habitIDs[i] = entry.HabitID
+		userIDs[i] = entry.UserID
+		resetDates[i] = entry.GetResetDate()
+		createdAt[i] = entry.CreatedAt
+	}
+
+	tag, err := r.pool.Exec(ctx, query, habitIDs, userIDs, resetDates, createdAt)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create queue entries: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// ClaimEntries захватывает до limit готовых к обработке записей на время lockFor.
+// Записи, захваченные параллельной транзакцией, пропускаются (FOR UPDATE SKIP LOCKED);
+// счетчик попыток увеличивается при захвате.
+func (r *StreakResetQueueRepository) ClaimEntries(ctx context.Context, limit int, lockFor time.Duration) ([]*domain.StreakResetQueue, error) {
+	query := `
+		UPDATE streak_reset_queue
+		SET locked_until = $2, attempts = attempts + 1
+		WHERE id IN (
+			SELECT id
+			FROM streak_reset_queue
+			WHERE processed = false AND dead_lettered_at IS NULL
+				AND next_attempt_at <= $1
+				AND (locked_until IS NULL OR locked_until < $1)
+			ORDER BY reset_date ASC, id ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
+	`
+
+	now := time.Now()
+	rows, err := r.pool.Query(ctx, query, now, now.Add(lockFor), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim queue entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.StreakResetQueue
+	for rows.Next() {
+		var entry domain.StreakResetQueue
+		err := rows.Scan(
+			&entry.ID,
+			&entry.HabitID,
+			&entry.UserID,
+			&entry.ResetDate,
+			&entry.Processed,
+			&entry.ProcessedAt,
+			&entry.PreviousStreak,
+			&entry.CreatedAt,
+			&entry.Attempts,
+			&entry.LastError,
+			&entry.NextAttemptAt,
+			&entry.LockedUntil,
+			&entry.DeadLetteredAt,
+			&entry.FrozenAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan queue entry: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetDeadLetterEntries получает записи, исчерпавшие попытки обработки
+func (r *StreakResetQueueRepository) GetDeadLetterEntries(ctx context.Context, limit int) ([]*domain.StreakResetQueue, error) {
+	query := `
+		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
+		FROM streak_reset_queue
+		WHERE dead_lettered_at IS NOT NULL
+		ORDER BY dead_lettered_at DESC
+		LIMIT $1
+	`
+
+	rows, err := r.pool.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead letter entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.StreakResetQueue
+	for rows.Next() {
+		var entry domain.StreakResetQueue
+		err := rows.Scan(
+			&entry.ID,
+			&entry.HabitID,
+			&entry.UserID,
+			&entry.ResetDate,
+			&entry.Processed,
+			&entry.ProcessedAt,
+			&entry.PreviousStreak,
+			&entry.CreatedAt,
+			&entry.Attempts,
+			&entry.LastError,
+			&entry.NextAttemptAt,
+			&entry.LockedUntil,
+			&entry.DeadLetteredAt,
+			&entry.FrozenAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan queue entry: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetFrozenEntries получает пропуски пользователя, закрытые заморозкой; habitID 0 - по всем привычкам
+func (r *StreakResetQueueRepository) GetFrozenEntries(ctx context.Context, userID, habitID, limit int) ([]*domain.StreakResetQueue, error) {
+	query := `
+		SELECT id, habit_id, user_id, reset_date, processed, processed_at, previous_streak, created_at,
+			attempts, last_error, next_attempt_at, locked_until, dead_lettered_at, frozen_at
+		FROM streak_reset_queue
+		WHERE user_id = $1 AND ($2 = 0 OR habit_id = $2) AND frozen_at IS NOT NULL
+		ORDER BY reset_date DESC
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, habitID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get frozen entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.StreakResetQueue
+	for rows.Next() {
+		var entry domain.StreakResetQueue
+		err := rows.Scan(
+			&entry.ID,
+			&entry.HabitID,
+			&entry.UserID,
+			&entry.ResetDate,
+			&entry.Processed,
+			&entry.ProcessedAt,
+			&entry.PreviousStreak,
+			&entry.CreatedAt,
+			&entry.Attempts,
+			&entry.LastError,
+			&entry.NextAttemptAt,
+			&entry.LockedUntil,
+			&entry.DeadLetteredAt,
+			&entry.FrozenAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan queue entry: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetFrozenDatesByHabitID получает даты пропусков привычки, закрытых заморозкой
+func (r *StreakResetQueueRepository) GetFrozenDatesByHabitID(ctx context.Context, habitID int) ([]time.Time, error) {
+	query := `
+		SELECT reset_date
+		FROM streak_reset_queue
+		WHERE habit_id = $1 AND frozen_at IS NOT NULL
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get frozen dates: %w", err)
+	}
+	defer rows.Close()
+
+	var dates []time.Time
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, fmt.Errorf("failed to scan frozen date: %w", err)
+		}
+		dates = append(dates, date)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating frozen dates: %w", err)
+	}
+
+	return dates, nil
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"HobitsService/internal/domain"
+)
+
+// TimerRepository реализация интерфейса TimerRepository для PostgreSQL
+type TimerRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTimerRepository создает новый TimerRepository
+func NewTimerRepository(pool *pgxpool.Pool) *TimerRepository {
+	return &TimerRepository{pool: pool}
+}
+
+// CreateTimer создает сессию таймера
+func (r *TimerRepository) CreateTimer(ctx context.Context, session *domain.TimerSession) (*domain.TimerSession, error) {
+	query := `
+		INSERT INTO timer_sessions (habit_id, user_id, started_at, stopped_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, habit_id, user_id, started_at, stopped_at
+	`
+
+	row := r.pool.QueryRow(ctx, query,
+		session.HabitID,
+		session.UserID,
+		session.StartedAt,
+		session.StoppedAt,
+	)
+
+	var result domain.TimerSession
+	err := row.Scan(
+		&result.ID,
+		&result.HabitID,
+		&result.UserID,
+		&result.StartedAt,
+		&result.StoppedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create timer: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetActiveTimer получает запущенный таймер пользователя; nil - таймер не запущен
+func (r *TimerRepository) GetActiveTimer(ctx context.Context, userID int) (*domain.TimerSession, error) {
+	query := `
+		SELECT id, habit_id, user_id, started_at, stopped_at
+		FROM timer_sessions
+		WHERE user_id = $1 AND stopped_at IS NULL
+	`
+
+	row := r.pool.QueryRow(ctx, query, userID)
+
+	var session domain.TimerSession
+	err := row.Scan(
+		&session.ID,
+		&session.HabitID,
+		&session.UserID,
+		&session.StartedAt,
+		&session.StoppedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active timer: %w", err)
+	}
+
+	return &session, nil
+}
+
+// StopTimer останавливает запущенный таймер в stoppedAt; nil - таймер уже остановлен
+func (r *TimerRepository) StopTimer(ctx context.Context, id int, stoppedAt time.Time) (*domain.TimerSession, error) {
+	query := `
+		UPDATE timer_sessions
+		SET stopped_at = $1
+		WHERE id = $2 AND stopped_at IS NULL
+		RETURNING id, habit_id, user_id, started_at, stopped_at
+	`
+
+	row := r.pool.QueryRow(ctx, query, stoppedAt, id)
+
+	var result domain.TimerSession
+	err := row.Scan(
+		&result.ID,
+		&result.HabitID,
+		&result.UserID,
+		&result.StartedAt,
+		&result.StoppedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stop timer: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetStoppedTimersByHabitID получает остановленные сессии привычки, пересекающиеся с [from, to)
+func (r *TimerRepository) GetStoppedTimersByHabitID(ctx context.Context, habitID int, from, to time.Time) ([]*domain.TimerSession, error) {
+	query := `
+		SELECT id, habit_id, user_id, started_at, stopped_at
+		FROM timer_sessions
+		WHERE habit_id = $1 AND stopped_at IS NOT NULL AND started_at < $3 AND stopped_at > $2
+		ORDER BY started_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, habitID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timers: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*domain.TimerSession
+	for rows.Next() {
+		var session domain.TimerSession
+		err := rows.Scan(
+			&session.ID,
+			&session.HabitID,
+			&session.UserID,
+			&session.StartedAt,
+			&session.StoppedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan timer: %w", err)
+		}
+		sessions = append(sessions, &session)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating timers: %w", err)
+	}
+
+	return sessions, nil
+}
@@ -22,9 +22,9 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 // CreateUser создает нового пользователя
 func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	query := `
-		INSERT INTO users (telegram_id, first_name, last_name, username, language_code, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, telegram_id, first_name, last_name, username, language_code, created_at, updated_at
+		INSERT INTO users (telegram_id, first_name, last_name, username, language_code, timezone, day_start_hour, log_grace_minutes, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, telegram_id, first_name, last_name, username, language_code, timezone, day_start_hour, log_grace_minutes, created_at, updated_at
 	`
 
 	row := r.pool.QueryRow(ctx, query,
@@ -33,6 +33,9 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) (*do
 		user.LastName,
 		user.Username,
 		user.LanguageCode,
+		user.Timezone,
+		user.DayStartHour,
+		user.LogGraceMinutes,
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
@@ -45,6 +48,9 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) (*do
 		&result.LastName,
 		&result.Username,
 		&result.LanguageCode,
+		&result.Timezone,
+		&result.DayStartHour,
+		&result.LogGraceMinutes,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	)
@@ -58,7 +64,7 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *domain.User) (*do
 // GetUserByID получает пользователя по ID
 func (r *UserRepository) GetUserByID(ctx context.Context, id int) (*domain.User, error) {
 	query := `
-		SELECT id, telegram_id, first_name, last_name, username, language_code, created_at, updated_at
+		SELECT id, telegram_id, first_name, last_name, username, language_code, timezone, day_start_hour, log_grace_minutes, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
@@ -73,6 +79,9 @@ func (r *UserRepository) GetUserByID(ctx context.Context, id int) (*domain.User,
 		&user.LastName,
 		&user.Username,
 		&user.LanguageCode,
+		&user.Timezone,
+		&user.DayStartHour,
+		&user.LogGraceMinutes,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -86,7 +95,7 @@ func (r *UserRepository) GetUserByID(ctx context.Context, id int) (*domain.User,
 // GetUserByTelegramID получает пользователя по Telegram ID
 func (r *UserRepository) GetUserByTelegramID(ctx context.Context, telegramID int64) (*domain.User, error) {
 	query := `
-		SELECT id, telegram_id, first_name, last_name, username, language_code, created_at, updated_at
+		SELECT id, telegram_id, first_name, last_name, username, language_code, timezone, day_start_hour, log_grace_minutes, created_at, updated_at
 		FROM users
 		WHERE telegram_id = $1
 	`
@@ -101,6 +110,9 @@ func (r *UserRepository) GetUserByTelegramID(ctx context.Context, telegramID int
 		&user.LastName,
 		&user.Username,
 		&user.LanguageCode,
+		&user.Timezone,
+		&user.DayStartHour,
+		&user.LogGraceMinutes,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -115,9 +127,10 @@ func (r *UserRepository) GetUserByTelegramID(ctx context.Context, telegramID int
 func (r *UserRepository) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	query := `
 		UPDATE users
-		SET first_name = $1, last_name = $2, username = $3, language_code = $4, updated_at = $5
-		WHERE id = $6
-		RETURNING id, telegram_id, first_name, last_name, username, language_code, created_at, updated_at
+		SET first_name = $1, last_name = $2, username = $3, language_code = $4, timezone = $5,
+			day_start_hour = $6, log_grace_minutes = $7, updated_at = $8
+		WHERE id = $9
+		RETURNING id, telegram_id, first_name, last_name, username, language_code, timezone, day_start_hour, log_grace_minutes, created_at, updated_at
 	`
 
 	row := r.pool.QueryRow(ctx, query,
@@ -125,6 +138,9 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *domain.User) (*do
 		user.LastName,
 		user.Username,
 		user.LanguageCode,
+		user.Timezone,
+		user.DayStartHour,
+		user.LogGraceMinutes,
 		user.UpdatedAt,
 		user.ID,
 	)
@@ -137,6 +153,9 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *domain.User) (*do
 		&result.LastName,
 		&result.Username,
 		&result.LanguageCode,
+		&result.Timezone,
+		&result.DayStartHour,
+		&result.LogGraceMinutes,
 		&result.CreatedAt,
 		&result.UpdatedAt,
 	)
@@ -150,7 +169,7 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *domain.User) (*do
 // GetAllUsers получает всех пользователей
 func (r *UserRepository) GetAllUsers(ctx context.Context) ([]*domain.User, error) {
 	query := `
-		SELECT id, telegram_id, first_name, last_name, username, language_code, created_at, updated_at
+		SELECT id, telegram_id, first_name, last_name, username, language_code, timezone, day_start_hour, log_grace_minutes, created_at, updated_at
 		FROM users
 		ORDER BY id ASC
 	`
@@ -171,6 +190,9 @@ func (r *UserRepository) GetAllUsers(ctx context.Context) ([]*domain.User, error
 			&user.LastName,
 			&user.Username,
 			&user.LanguageCode,
+			&user.Timezone,
+			&user.DayStartHour,
+			&user.LogGraceMinutes,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -196,3 +218,47 @@ func (r *UserRepository) DeleteUser(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// GetUsersByIDs получает пользователей по списку ID
+func (r *UserRepository) GetUsersByIDs(ctx context.Context, ids []int) ([]*domain.User, error) {
+	query := `
+		SELECT id, telegram_id, first_name, last_name, username, language_code, timezone, day_start_hour, log_grace_minutes, created_at, updated_at
+		FROM users
+		WHERE id = ANY($1)
+		ORDER BY id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by ids: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*domain.User
+	for rows.Next() {
+		var user domain.User
+		err := rows.Scan(
+			&user.ID,
+			&user.TelegramID,
+			&user.FirstName,
+			&user.LastName,
+			&user.Username,
+			&user.LanguageCode,
+			&user.Timezone,
+			&user.DayStartHour,
+			&user.LogGraceMinutes,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, &user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating users: %w", err)
+	}
+
+	return users, nil
+}
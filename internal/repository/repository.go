@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"HobitsService/internal/domain"
@@ -17,6 +18,8 @@ type UserRepository interface {
 	GetUserByTelegramID(ctx context.Context, telegramID int64) (*domain.User, error)
 	// GetAllUsers получает всех пользователей
 	GetAllUsers(ctx context.Context) ([]*domain.User, error)
+	// GetUsersByIDs получает пользователей по списку ID
+	GetUsersByIDs(ctx context.Context, ids []int) ([]*domain.User, error)
 	// UpdateUser обновляет пользователя
 	UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error)
 	// DeleteUser удаляет пользователя
@@ -35,12 +38,28 @@ type HabitRepository interface {
 	GetActiveHabitsByUserID(ctx context.Context, userID int) ([]*domain.Habit, error)
 	// GetAllActiveHabits получает все активные привычки
 	GetAllActiveHabits(ctx context.Context) ([]*domain.Habit, error)
+	// GetActiveHabitsAfterID получает порцию активных привычек с ID больше afterID (keyset пагинация)
+	GetActiveHabitsAfterID(ctx context.Context, afterID, limit int) ([]*domain.Habit, error)
+	// UpdateLastCheckedDates обновляет last_checked_date у нескольких привычек одним запросом
+	UpdateLastCheckedDates(ctx context.Context, dates map[int]time.Time) error
 	// UpdateHabit обновляет привычку
 	UpdateHabit(ctx context.Context, habit *domain.Habit) (*domain.Habit, error)
+	// UpdateStreak обновляет только текущий и лучший стрик и дату последнего выполнения
+	UpdateStreak(ctx context.Context, habitID, current, best int, lastCompleted sql.NullTime) (*domain.Habit, error)
 	// DeleteHabit удаляет привычку
 	DeleteHabit(ctx context.Context, id int) error
 	// GetHabitByUserIDAndName получает привычку по user ID и названию
 	GetHabitByUserIDAndName(ctx context.Context, userID int, name string) (*domain.Habit, error)
+	// GetFormedHabitsByUserID получает выработанные привычки пользователя, последние выработанные первыми
+	GetFormedHabitsByUserID(ctx context.Context, userID int) ([]*domain.Habit, error)
+	// MarkHabitFormed отмечает привычку выработанной и в том же запросе сохраняет событие в outbox;
+	// nil, если привычка уже была отмечена
+	MarkHabitFormed(ctx context.Context, habit *domain.Habit, event *domain.HabitEvent) (*domain.Habit, error)
+	// GetChallengesEndedBefore получает активные привычки-челленджи с датой окончания раньше date
+	GetChallengesEndedBefore(ctx context.Context, date time.Time) ([]*domain.Habit, error)
+	// EndChallenge деактивирует завершившуюся привычку-челлендж и в том же запросе сохраняет событие в outbox;
+	// nil, если привычка уже была деактивирована
+	EndChallenge(ctx context.Context, habit *domain.Habit, event *domain.HabitEvent) (*domain.Habit, error)
 }
 
 // HabitLogRepository определяет интерфейс для работы с логами привычек
@@ -53,8 +72,12 @@ type HabitLogRepository interface {
 	GetLogsByHabitID(ctx context.Context, habitID int) ([]*domain.HabitLog, error)
 	// GetLogsByHabitIDAndDate получает логи за определенный период
 	GetLogsByHabitIDAndDate(ctx context.Context, habitID int, from, to time.Time) ([]*domain.HabitLog, error)
-	// GetLogByHabitIDAndDate получает лог за конкретный день
+	// GetLogsByHabitIDsAndDate получает логи нескольких привычек за период
+	GetLogsByHabitIDsAndDate(ctx context.Context, habitIDs []int, from, to time.Time) ([]*domain.HabitLog, error)
+	// GetLogByHabitIDAndDate получает первый лог за конкретный день
 	GetLogByHabitIDAndDate(ctx context.Context, habitID int, date time.Time) (*domain.HabitLog, error)
+	// AddLogValue атомарно прибавляет value к значению лога; непустой comment заменяет комментарий
+	AddLogValue(ctx context.Context, id int, value float64, comment string) (*domain.HabitLog, error)
 	// DeleteLog удаляет лог
 	DeleteLog(ctx context.Context, id int) error
 	// CountLogsByHabitIDAndDate считает логи за период
@@ -85,6 +108,8 @@ type HabitReminderRepository interface {
 type StreakResetQueueRepository interface {
 	// CreateQueueEntry создает новую запись в очередь
 	CreateQueueEntry(ctx context.Context, entry *domain.StreakResetQueue) (*domain.StreakResetQueue, error)
+	// CreateQueueEntries добавляет записи в очередь, пропуская уже существующие; возвращает число добавленных
+	CreateQueueEntries(ctx context.Context, entries []*domain.StreakResetQueue) (int, error)
 	// GetQueueEntryByID получает запись по ID
 	GetQueueEntryByID(ctx context.Context, id int) (*domain.StreakResetQueue, error)
 	// GetUnprocessedEntries получает необработанные записи
@@ -97,4 +122,102 @@ type StreakResetQueueRepository interface {
 	DeleteQueueEntry(ctx context.Context, id int) error
 	// GetQueueEntryByHabitIDAndDate получает запись по привычке и дате
 	GetQueueEntryByHabitIDAndDate(ctx context.Context, habitID int, date time.Time) (*domain.StreakResetQueue, error)
+	// ClaimEntries захватывает до limit готовых к обработке записей на время lockFor
+	ClaimEntries(ctx context.Context, limit int, lockFor time.Duration) ([]*domain.StreakResetQueue, error)
+	// GetDeadLetterEntries получает записи, исчерпавшие попытки обработки
+	GetDeadLetterEntries(ctx context.Context, limit int) ([]*domain.StreakResetQueue, error)
+	// GetFrozenEntries получает пропуски пользователя, закрытые заморозкой; habitID 0 - по всем привычкам
+	GetFrozenEntries(ctx context.Context, userID, habitID, limit int) ([]*domain.StreakResetQueue, error)
+	// GetFrozenDatesByHabitID получает даты пропусков привычки, закрытых заморозкой
+	GetFrozenDatesByHabitID(ctx context.Context, habitID int) ([]time.Time, error)
+}
+
+// StreakFreezeRepository определяет интерфейс для работы с заморозками стриков
+type StreakFreezeRepository interface {
+	// GetBalance получает баланс заморозок пользователя; без записи баланс нулевой
+	GetBalance(ctx context.Context, userID int) (*domain.StreakFreezeBalance, error)
+	// AddTokens добавляет пользователю amount заморозок
+	AddTokens(ctx context.Context, userID, amount int) (*domain.StreakFreezeBalance, error)
+	// EarnToken начисляет заморозку за рубеж стрика один раз, не превышая maxTokens; true - рубеж новый
+	EarnToken(ctx context.Context, userID, habitID int, streakStart time.Time, milestone, maxTokens int) (bool, error)
+	// ConsumeToken тратит заморозку на пропуск entryID; false - заморозок нет или запись уже заморожена
+	ConsumeToken(ctx context.Context, userID, entryID int) (time.Time, bool, error)
+}
+
+// PauseRepository определяет интерфейс для работы с паузами привычек и отпусками
+type PauseRepository interface {
+	// CreatePause создает паузу
+	CreatePause(ctx context.Context, pause *domain.Pause) (*domain.Pause, error)
+	// GetPauseByID получает паузу по ID
+	GetPauseByID(ctx context.Context, id int) (*domain.Pause, error)
+	// GetPausesByUserID получает все паузы пользователя, включая отмененные
+	GetPausesByUserID(ctx context.Context, userID int) ([]*domain.Pause, error)
+	// GetPausesByUserIDs получает паузы пользователей, пересекающиеся с периодом [from, to]
+	GetPausesByUserIDs(ctx context.Context, userIDs []int, from, to time.Time) ([]*domain.Pause, error)
+	// UpdatePause обновляет паузу
+	UpdatePause(ctx context.Context, pause *domain.Pause) (*domain.Pause, error)
+}
+
+// RelapseRepository определяет интерфейс для работы со срывами привычек-отказов
+type RelapseRepository interface {
+	// CreateRelapse создает запись о срыве
+	CreateRelapse(ctx context.Context, relapse *domain.Relapse) (*domain.Relapse, error)
+	// GetRelapsesByHabitID получает все срывы привычки, последние первыми
+	GetRelapsesByHabitID(ctx context.Context, habitID int) ([]*domain.Relapse, error)
+}
+
+// SkipRepository определяет интерфейс для работы с уважительными пропусками дней
+type SkipRepository interface {
+	// CreateSkip создает пропуск дня; повторный пропуск того же дня обновляет причину
+	CreateSkip(ctx context.Context, skip *domain.Skip) (*domain.Skip, error)
+	// GetSkipsByHabitIDs получает пропуски привычек за период [from, to]
+	GetSkipsByHabitIDs(ctx context.Context, habitIDs []int, from, to time.Time) ([]*domain.Skip, error)
+}
+
+// ScheduleRevisionRepository определяет интерфейс для работы с редакциями расписания привычек
+type ScheduleRevisionRepository interface {
+	// CreateScheduleRevision сохраняет редакцию; повторная редакция с той же даты заменяет предыдущую
+	CreateScheduleRevision(ctx context.Context, revision *domain.ScheduleRevision) (*domain.ScheduleRevision, error)
+	// GetScheduleRevisionsByHabitIDs получает все редакции расписания привычек
+	GetScheduleRevisionsByHabitIDs(ctx context.Context, habitIDs []int) ([]*domain.ScheduleRevision, error)
+}
+
+// TimerRepository определяет интерфейс для работы с сессиями таймера
+type TimerRepository interface {
+	// CreateTimer создает сессию таймера
+	CreateTimer(ctx context.Context, session *domain.TimerSession) (*domain.TimerSession, error)
+	// GetActiveTimer получает запущенный таймер пользователя; nil - таймер не запущен
+	GetActiveTimer(ctx context.Context, userID int) (*domain.TimerSession, error)
+	// StopTimer останавливает запущенный таймер в stoppedAt; nil - таймер уже остановлен
+	StopTimer(ctx context.Context, id int, stoppedAt time.Time) (*domain.TimerSession, error)
+	// GetStoppedTimersByHabitID получает остановленные сессии привычки, пересекающиеся с [from, to)
+	GetStoppedTimersByHabitID(ctx context.Context, habitID int, from, to time.Time) ([]*domain.TimerSession, error)
+}
+
+// HabitEventRepository определяет интерфейс для работы с outbox событий привычек
+type HabitEventRepository interface {
+	// GetUnpublishedEvents получает до limit неопубликованных событий типов types в порядке создания
+	GetUnpublishedEvents(ctx context.Context, types []domain.HabitEventType, limit int) ([]*domain.HabitEvent, error)
+	// MarkEventPublished отмечает событие опубликованным
+	MarkEventPublished(ctx context.Context, id int) error
+}
+
+// SchedulerRunRepository определяет интерфейс для работы с последними успешными запусками задач
+type SchedulerRunRepository interface {
+	// GetSchedulerRuns получает последние успешные запуски всех задач
+	GetSchedulerRuns(ctx context.Context) ([]*domain.SchedulerRun, error)
+	// SaveSchedulerRun сохраняет последний успешный запуск задачи
+	SaveSchedulerRun(ctx context.Context, run *domain.SchedulerRun) (*domain.SchedulerRun, error)
+}
+
+// JobRunRepository определяет интерфейс для работы с историей запусков задач
+type JobRunRepository interface {
+	// CreateJobRun создает запись о запуске задачи
+	CreateJobRun(ctx context.Context, run *domain.JobRun) (*domain.JobRun, error)
+	// GetJobRunByID получает запуск по ID
+	GetJobRunByID(ctx context.Context, id int) (*domain.JobRun, error)
+	// GetJobRuns получает последние запуски, jobName пустой - по всем задачам
+	GetJobRuns(ctx context.Context, jobName string, limit int) ([]*domain.JobRun, error)
+	// UpdateJobRun обновляет запись о запуске
+	UpdateJobRun(ctx context.Context, run *domain.JobRun) (*domain.JobRun, error)
 }
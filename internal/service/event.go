@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"HobitsService/internal/config"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/repository"
+)
+
+// publishedEventTypes типы событий из outbox, которые публикуются внешним потребителям
+var publishedEventTypes = []domain.HabitEventType{
+	domain.HabitEventFormed,
+	domain.HabitEventChallengeEnded,
+}
+
+// EventPublisher доставляет события привычек внешним потребителям
+type EventPublisher interface {
+	// Publish публикует событие. Доставка не реже одного раза: потребители различают повторы по ID события
+	Publish(ctx context.Context, event *domain.HabitEvent) error
+}
+
+// EventService публикует события из outbox
+type EventService struct {
+	eventRepo repository.HabitEventRepository
+	publisher EventPublisher
+	eventsCfg config.EventsConfig
+}
+
+// NewEventService создает новый EventService; без publisher события остаются в outbox
+func NewEventService(
+	eventRepo repository.HabitEventRepository,
+	publisher EventPublisher,
+	eventsCfg config.EventsConfig,
+) *EventService {
+	return &EventService{
+		eventRepo: eventRepo,
+		publisher: publisher,
+		eventsCfg: eventsCfg,
+	}
+}
+
+// Enabled проверяет, настроен ли потребитель событий
+func (s *EventService) Enabled() bool {
+	return s.publisher != nil
+}
+
+// PublishPendingEvents публикует неопубликованные события в порядке создания и отмечает их опубликованными.
+// На первой ошибке доставки останавливается, чтобы события не обгоняли друг друга; событие повторится
+// при следующем запуске.
+func (s *EventService) PublishPendingEvents(ctx context.Context) (published, failed int, err error) {
+	if !s.Enabled() {
+		return 0, 0, nil
+	}
+
+	limit := max(s.eventsCfg.BatchSize, 1)
+	for {
+		events, err := s.eventRepo.GetUnpublishedEvents(ctx, publishedEventTypes, limit)
+		if err != nil {
+			return published, failed, err
+		}
+
+		for _, event := range events {
+			if err := ctx.Err(); err != nil {
+				return published, failed, err
+			}
+
+			if err := s.publisher.Publish(ctx, event); err != nil {
+				logger.Error("Failed to publish event",
+					zap.Int("event_id", event.ID),
+					zap.String("event_type", string(event.Type)),
+					zap.Error(err),
+				)
+				return published, failed + 1, fmt.Errorf("failed to publish event %d: %w", event.ID, err)
+			}
+			if err := s.eventRepo.MarkEventPublished(ctx, event.ID); err != nil {
+				return published, failed + 1, err
+			}
+			published++
+		}
+
+		if len(events) < limit {
+			return published, failed, nil
+		}
+	}
+}
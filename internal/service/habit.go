@@ -3,38 +3,70 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"go.uber.org/zap"
+
 	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
 	"HobitsService/internal/repository"
 )
 
 // HabitService сервис для управления привычками
 type HabitService struct {
+	userRepo     repository.UserRepository
 	habitRepo    repository.HabitRepository
 	logRepo      repository.HabitLogRepository
 	reminderRepo repository.HabitReminderRepository
+	pauseRepo    repository.PauseRepository
+	relapseRepo  repository.RelapseRepository
+	skipRepo     repository.SkipRepository
+	scheduleRepo repository.ScheduleRevisionRepository
+
+	freezeService *StreakFreezeService
 }
 
 // NewHabitService создает новый HabitService
 func NewHabitService(
+	userRepo repository.UserRepository,
 	habitRepo repository.HabitRepository,
 	logRepo repository.HabitLogRepository,
 	reminderRepo repository.HabitReminderRepository,
+	pauseRepo repository.PauseRepository,
+	relapseRepo repository.RelapseRepository,
+	skipRepo repository.SkipRepository,
+	scheduleRepo repository.ScheduleRevisionRepository,
+	freezeService *StreakFreezeService,
 ) *HabitService {
 	return &HabitService{
+		userRepo:     userRepo,
 		habitRepo:    habitRepo,
 		logRepo:      logRepo,
 		reminderRepo: reminderRepo,
+		pauseRepo:    pauseRepo,
+		relapseRepo:  relapseRepo,
+		skipRepo:     skipRepo,
+		scheduleRepo: scheduleRepo,
+
+		freezeService: freezeService,
 	}
 }
 
 // CreateHabit создает новую привычку для пользователя
 func (s *HabitService) CreateHabit(ctx context.Context, userID int, name string, frequency domain.HabitFrequency) (*domain.Habit, error) {
-	habit := domain.NewHabit(userID, name, frequency)
-	return s.habitRepo.CreateHabit(ctx, habit)
+	habit, err := s.habitRepo.CreateHabit(ctx, domain.NewHabit(userID, name, frequency))
+	if err != nil {
+		return nil, err
+	}
+
+	// Первая редакция расписания действует и для дней до создания, отмеченных задним числом
+	if err := s.recordSchedule(ctx, habit); err != nil {
+		return nil, err
+	}
+	return habit, nil
 }
 
 // GetHabit получает привычку по ID
@@ -97,11 +129,11 @@ func (s *HabitService) SetWeeklyDays(ctx context.Context, habitID int, days []in
 	daysStr := s.daysToString(days)
 	habit.SetWeeklyDays(daysStr)
 
-	return s.habitRepo.UpdateHabit(ctx, habit)
+	return s.saveSchedule(ctx, habit)
 }
 
-// SetMonthlyDays устанавливает дни месяца для ежемесячной привычки
-func (s *HabitService) SetMonthlyDays(ctx context.Context, habitID int, days []int) (*domain.Habit, error) {
+// SetMonthlyDays устанавливает правила ежемесячной привычки: дни месяца 1-31, "last" и дни недели вида "2nd TUE"
+func (s *HabitService) SetMonthlyDays(ctx context.Context, habitID int, rules []string) (*domain.Habit, error) {
 	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
 	if err != nil {
 		return nil, err
@@ -111,46 +143,414 @@ func (s *HabitService) SetMonthlyDays(ctx context.Context, habitID int, days []i
 		return nil, fmt.Errorf("habit is not monthly")
 	}
 
-	// Преобразуем массив дней в строку "1,15,28"
-	daysStr := s.daysToString(days)
-	habit.SetMonthlyDays(daysStr)
+	parsed := make([]domain.MonthlyRule, 0, len(rules))
+	for _, r := range rules {
+		rule, err := domain.ParseMonthlyRule(r)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, rule)
+	}
 
-	return s.habitRepo.UpdateHabit(ctx, habit)
+	// Сохраняем в каноническом виде "1,15,last,2TUE"
+	habit.SetMonthlyDays(domain.FormatMonthlyDays(parsed))
+
+	return s.saveSchedule(ctx, habit)
 }
 
-// GetScheduledDaysForToday возвращает, нужно ли подтверждение сегодня
-func (s *HabitService) GetScheduledDaysForToday(ctx context.Context, habitID int) (bool, error) {
+// SetInterval устанавливает интервал для привычки "каждые N дней".
+// Нулевая anchor означает "сегодня" владельца привычки.
+func (s *HabitService) SetInterval(ctx context.Context, habitID, days int, anchor time.Time) (*domain.Habit, error) {
 	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+
+	if habit.Frequency != domain.FrequencyInterval {
+		return nil, fmt.Errorf("habit is not interval")
+	}
+
+	if anchor.IsZero() {
+		anchor, err = s.userToday(ctx, habit.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := habit.SetInterval(days, anchor); err != nil {
+		return nil, err
+	}
+
+	return s.saveSchedule(ctx, habit)
+}
+
+// SetQuota устанавливает квоту "target раз за неделю/месяц" для квотной привычки
+func (s *HabitService) SetQuota(ctx context.Context, habitID, target int, period domain.QuotaPeriod) (*domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if habit.Frequency != domain.FrequencyQuota {
+		return nil, fmt.Errorf("habit is not quota")
+	}
+
+	if err := habit.SetQuota(target, period); err != nil {
+		return nil, err
+	}
+
+	return s.saveSchedule(ctx, habit)
+}
+
+// SetRRule задает привычке правило повторения RFC 5545, пустое правило возвращает расписание по frequency.
+// Нулевая start означает "сегодня" владельца привычки.
+func (s *HabitService) SetRRule(ctx context.Context, habitID int, rrule string, start time.Time) (*domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rrule == "" {
+		habit.SetRRule(nil, time.Time{})
+		return s.saveSchedule(ctx, habit)
+	}
+
+	if habit.IsQuota() || habit.IsQuit() {
+		return nil, fmt.Errorf("%w: %s habits are not scheduled by days", domain.ErrInvalidRRule, habit.Frequency)
+	}
+
+	rule, err := domain.ParseRRule(rrule)
+	if err != nil {
+		return nil, err
+	}
+
+	if start.IsZero() {
+		start, err = s.userToday(ctx, habit.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	habit.SetRRule(rule, start)
+
+	return s.saveSchedule(ctx, habit)
+}
+
+// SetTarget задает дневную цель количественной привычки; value 0 делает привычку бинарной
+func (s *HabitService) SetTarget(ctx context.Context, habitID int, value float64, unit string) (*domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := habit.SetTarget(value, unit); err != nil {
+		return nil, err
+	}
+
+	habit, err = s.habitRepo.UpdateHabit(ctx, habit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Смена цели меняет засчитанные дни
+	return s.recomputeStreak(ctx, habit)
+}
+
+// SetDailyCount задает, сколько раз за день нужно выполнить привычку
+func (s *HabitService) SetDailyCount(ctx context.Context, habitID, count int) (*domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := habit.SetDailyCount(count); err != nil {
+		return nil, err
+	}
+
+	habit, err = s.habitRepo.UpdateHabit(ctx, habit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Смена числа выполнений меняет засчитанные дни
+	return s.recomputeStreak(ctx, habit)
+}
+
+// SetGraduation задает критерии выработки привычки и сразу проверяет их
+func (s *HabitService) SetGraduation(ctx context.Context, habitID, streak, rate, weeks int) (*domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := habit.SetGraduation(streak, rate, weeks); err != nil {
+		return nil, err
+	}
+
+	habit, err = s.habitRepo.UpdateHabit(ctx, habit)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.checkGraduation(ctx, habit)
+}
+
+// GetFormedHabits получает выработанные привычки пользователя
+func (s *HabitService) GetFormedHabits(ctx context.Context, userID int) ([]*domain.Habit, error) {
+	return s.habitRepo.GetFormedHabitsByUserID(ctx, userID)
+}
+
+// graduationCheckBatchSize размер порции привычек при ночной проверке выработки
+const graduationCheckBatchSize = 500
+
+// CheckGraduations проверяет критерии выработки у всех активных привычек.
+// Ошибка проверки отдельной привычки не прерывает обход и учитывается в failed.
+func (s *HabitService) CheckGraduations(ctx context.Context) (checked, formed, failed int, err error) {
+	afterID := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return checked, formed, failed, err
+		}
+
+		habits, err := s.habitRepo.GetActiveHabitsAfterID(ctx, afterID, graduationCheckBatchSize)
+		if err != nil {
+			return checked, formed, failed, fmt.Errorf("failed to get active habits: %w", err)
+		}
+		if len(habits) == 0 {
+			break
+		}
+
+		for _, habit := range habits {
+			if habit.IsCompleted || !habit.HasGraduation() {
+				continue
+			}
+			checked++
+
+			updated, err := s.checkGraduation(ctx, habit)
+			if err != nil {
+				logger.Error("Failed to check habit graduation", zap.Int("habit_id", habit.ID), zap.Error(err))
+				failed++
+				continue
+			}
+			if updated.IsCompleted {
+				formed++
+			}
+		}
+
+		afterID = habits[len(habits)-1].ID
+		if len(habits) < graduationCheckBatchSize {
+			break
+		}
 	}
 
-	return s.isHabitScheduledForDate(habit, time.Now()), nil
+	return checked, formed, failed, nil
 }
 
-// isHabitScheduledForDate проверяет, запланирована ли привычка на дату
-func (s *HabitService) isHabitScheduledForDate(habit *domain.Habit, date time.Time) bool {
-	switch habit.Frequency {
-	case domain.FrequencyDaily:
-		return true
+// checkGraduation отмечает привычку выработанной, если выполнен один из ее критериев:
+// напоминания по ней больше не создаются, а в outbox сохраняется событие habit.formed
+func (s *HabitService) checkGraduation(ctx context.Context, habit *domain.Habit) (*domain.Habit, error) {
+	if habit.IsCompleted || !habit.HasGraduation() {
+		return habit, nil
+	}
 
-	case domain.FrequencyWeekly:
-		if !habit.WeeklyDays.Valid {
-			return false
+	today, err := s.userToday(ctx, habit.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rate float64
+	from, to, rateKnown := habit.GraduationWindow(today)
+	if rateKnown {
+		rate, err = s.completionRate(ctx, habit, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get completion rate: %w", err)
 		}
-		weekday := s.goWeekdayToInt(date.Weekday())
-		return s.containsDay(habit.WeeklyDays.String, weekday)
+	}
+
+	if !habit.MeetsGraduation(rate, rateKnown) {
+		return habit, nil
+	}
+
+	habit.MarkAsCompleted()
+	formed, err := s.habitRepo.MarkHabitFormed(ctx, habit, domain.NewHabitFormedEvent(habit, rate))
+	if err != nil {
+		return nil, err
+	}
+	if formed == nil {
+		// Привычку уже отметили параллельно
+		return s.habitRepo.GetHabitByID(ctx, habit.ID)
+	}
+
+	logger.Info("Habit formed", zap.Int("habit_id", formed.ID), zap.Int("user_id", formed.UserID),
+		zap.Int("current_streak", formed.CurrentStreak), zap.Float64("completion_rate", rate))
+	return formed, nil
+}
+
+// SetChallenge задает окно челленджа [start, end]; нулевые даты снимают соответствующую границу
+func (s *HabitService) SetChallenge(ctx context.Context, habitID int, start, end time.Time) (*domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := habit.SetChallenge(start, end); err != nil {
+		return nil, err
+	}
+
+	habit, err = s.habitRepo.UpdateHabit(ctx, habit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Дни вне окна больше не планируются, поэтому стрик пересчитывается
+	return s.recomputeStreak(ctx, habit)
+}
+
+// GetChallengeReport считает отчет челленджа на текущий день владельца:
+// итоговый, если челлендж закончился, иначе промежуточный
+func (s *HabitService) GetChallengeReport(ctx context.Context, habitID int) (*domain.ChallengeReport, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+	if !habit.IsChallenge() {
+		return nil, domain.ErrNotChallenge
+	}
 
-	case domain.FrequencyMonthly:
-		if !habit.MonthlyDays.Valid {
-			return false
+	today, err := s.userToday(ctx, habit.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.challengeReport(ctx, habit, today)
+}
+
+// challengeReport загружает историю привычки и считает по ней отчет челленджа на дату today
+func (s *HabitService) challengeReport(ctx context.Context, habit *domain.Habit, today time.Time) (*domain.ChallengeReport, error) {
+	if habit.IsQuit() {
+		relapses, err := s.relapseRepo.GetRelapsesByHabitID(ctx, habit.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relapses: %w", err)
 		}
-		day := date.Day()
-		return s.containsDay(habit.MonthlyDays.String, day)
+		return habit.ChallengeReport(relapseDates(relapses), today)
+	}
 
-	default:
-		return false
+	logs, err := s.logRepo.GetLogsByHabitID(ctx, habit.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs: %w", err)
 	}
+
+	if err := s.attachSchedules(ctx, habit); err != nil {
+		return nil, err
+	}
+	if err := s.attachPauses(ctx, time.Time{}, today, habit); err != nil {
+		return nil, err
+	}
+	if err := s.attachSkips(ctx, time.Time{}, today, habit); err != nil {
+		return nil, err
+	}
+
+	return habit.ChallengeReport(habit.CompletedDates(logs), today)
+}
+
+// EndChallenges деактивирует привычки-челленджи, закончившиеся к текущему дню владельца,
+// и сохраняет их итоговые отчеты в outbox. Ошибка по отдельной привычке не прерывает обход и учитывается в failed.
+func (s *HabitService) EndChallenges(ctx context.Context) (checked, ended, failed int, err error) {
+	// Ни в одном часовом поясе текущий день не позже завтрашней даты UTC
+	habits, err := s.habitRepo.GetChallengesEndedBefore(ctx, domain.DateOf(time.Now().UTC()).AddDate(0, 0, 1))
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to get ended challenges: %w", err)
+	}
+
+	for _, habit := range habits {
+		if err := ctx.Err(); err != nil {
+			return checked, ended, failed, err
+		}
+		checked++
+
+		updated, err := s.endChallenge(ctx, habit)
+		if err != nil {
+			logger.Error("Failed to end challenge", zap.Int("habit_id", habit.ID), zap.Error(err))
+			failed++
+			continue
+		}
+		if !updated.IsActive {
+			ended++
+		}
+	}
+
+	return checked, ended, failed, nil
+}
+
+// endChallenge деактивирует привычку, если ее челлендж закончился у владельца; последний день
+// можно отметить в окне льготы, поэтому до его окончания привычка остается активной
+func (s *HabitService) endChallenge(ctx context.Context, habit *domain.Habit) (*domain.Habit, error) {
+	today, logDate, err := s.userLogDate(ctx, habit.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !habit.IsChallengeOverOn(logDate) {
+		return habit, nil
+	}
+
+	report, err := s.challengeReport(ctx, habit, today)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build challenge report: %w", err)
+	}
+
+	habit.Deactivate()
+	ended, err := s.habitRepo.EndChallenge(ctx, habit, domain.NewChallengeEndedEvent(habit, report))
+	if err != nil {
+		return nil, err
+	}
+	if ended == nil {
+		// Привычку уже деактивировали параллельно
+		return s.habitRepo.GetHabitByID(ctx, habit.ID)
+	}
+
+	logger.Info("Challenge ended", zap.Int("habit_id", ended.ID), zap.Int("user_id", ended.UserID),
+		zap.Int("days_done", report.Done), zap.Int("days_missed", report.Missed), zap.Float64("completion_rate", report.Rate))
+	return ended, nil
+}
+
+// GetScheduledDaysForToday возвращает, нужно ли подтверждение сегодня
+func (s *HabitService) GetScheduledDaysForToday(ctx context.Context, habitID int) (bool, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return false, err
+	}
+
+	today, err := s.userToday(ctx, habit.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.attachPauses(ctx, today, today, habit); err != nil {
+		return false, err
+	}
+	if err := s.attachSchedules(ctx, habit); err != nil {
+		return false, err
+	}
+
+	return habit.IsScheduledOn(today), nil
+}
+
+// userToday возвращает текущую дату в часовом поясе пользователя
+func (s *HabitService) userToday(ctx context.Context, userID int) (time.Time, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user.Today(), nil
+}
+
+// userLogDate возвращает текущую дату пользователя и дату, в которую засчитывается отмеченное сейчас выполнение
+func (s *HabitService) userLogDate(ctx context.Context, userID int) (today, logDate time.Time, err error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user.Today(), user.LogDate(), nil
 }
 
 // GetScheduledDaysBetween возвращает все запланированные дни между двумя датами
@@ -160,17 +560,168 @@ func (s *HabitService) GetScheduledDaysBetween(ctx context.Context, habitID int,
 		return nil, err
 	}
 
-	var scheduledDays []time.Time
-	current := from
+	if err := s.attachPauses(ctx, from, to, habit); err != nil {
+		return nil, err
+	}
+	if err := s.attachSchedules(ctx, habit); err != nil {
+		return nil, err
+	}
+
+	return habit.ScheduledDaysBetween(from, to), nil
+}
+
+// attachPauses загружает паузы, пересекающиеся с периодом [from, to], и передает их привычкам
+func (s *HabitService) attachPauses(ctx context.Context, from, to time.Time, habits ...*domain.Habit) error {
+	if len(habits) == 0 {
+		return nil
+	}
 
-	for current.Before(to) || current.Equal(to) {
-		if s.isHabitScheduledForDate(habit, current) {
-			scheduledDays = append(scheduledDays, current)
+	seen := make(map[int]bool)
+	var userIDs []int
+	for _, habit := range habits {
+		if !seen[habit.UserID] {
+			seen[habit.UserID] = true
+			userIDs = append(userIDs, habit.UserID)
 		}
-		current = current.AddDate(0, 0, 1)
 	}
 
-	return scheduledDays, nil
+	pauses, err := s.pauseRepo.GetPausesByUserIDs(ctx, userIDs, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to get pauses: %w", err)
+	}
+
+	for _, habit := range habits {
+		habit.SetPauses(pauses)
+	}
+	return nil
+}
+
+// completionRate считает процент выполнения привычки за период [from, to]
+func (s *HabitService) completionRate(ctx context.Context, habit *domain.Habit, from, to time.Time) (float64, error) {
+	// Прошлые дни оцениваются по расписанию, действовавшему в них
+	if err := s.attachSchedules(ctx, habit); err != nil {
+		return 0, err
+	}
+
+	// Паузы нужны и за неполные периоды квоты на границах интервала
+	pausesFrom, pausesTo := from, to
+	if habit.IsQuota() {
+		pausesFrom, _ = habit.QuotaPeriodBounds(from)
+		_, pausesTo = habit.QuotaPeriodBounds(to)
+	}
+	if err := s.attachPauses(ctx, pausesFrom, pausesTo, habit); err != nil {
+		return 0, err
+	}
+
+	// Квотные привычки считаются относительно квоты за периоды, а не по дням
+	if habit.IsQuota() {
+		logs, err := s.logRepo.GetLogsByHabitIDAndDate(ctx, habit.ID, from, to)
+		if err != nil {
+			return 0, err
+		}
+		return habit.QuotaCompletionRate(habit.CompletedDates(logs), from, to), nil
+	}
+
+	if err := s.attachSkips(ctx, from, to, habit); err != nil {
+		return 0, err
+	}
+
+	logs, err := s.logRepo.GetLogsByHabitIDAndDate(ctx, habit.ID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	// Выполнения в дни паузы не учитываются, как и сами дни;
+	// у количественной привычки засчитываются только дни с достигнутой целью
+	completed := make(map[time.Time]bool)
+	for _, day := range habit.CompletedDates(logs) {
+		if !habit.IsPausedOn(day) {
+			completed[day] = true
+		}
+	}
+
+	// Уважительно пропущенные дни без выполнения не учитываются: они считаются отдельно
+	scheduled := 0
+	for _, day := range habit.ScheduledDaysBetween(from, to) {
+		if completed[day] || !habit.IsSkippedOn(day) {
+			scheduled++
+		}
+	}
+
+	if scheduled == 0 {
+		return 0, nil
+	}
+
+	return float64(len(completed)) / float64(scheduled) * 100, nil
+}
+
+// attachSchedules загружает редакции расписания привычек и передает их привычкам
+func (s *HabitService) attachSchedules(ctx context.Context, habits ...*domain.Habit) error {
+	if len(habits) == 0 {
+		return nil
+	}
+
+	habitIDs := make([]int, len(habits))
+	for i, habit := range habits {
+		habitIDs[i] = habit.ID
+	}
+
+	revisions, err := s.scheduleRepo.GetScheduleRevisionsByHabitIDs(ctx, habitIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get schedule revisions: %w", err)
+	}
+
+	for _, habit := range habits {
+		habit.SetScheduleRevisions(revisions)
+	}
+	return nil
+}
+
+// saveSchedule сохраняет изменение расписания и записывает его редакцию, действующую с "сегодня" владельца:
+// прошлые дни продолжают оцениваться по прежнему расписанию
+func (s *HabitService) saveSchedule(ctx context.Context, habit *domain.Habit) (*domain.Habit, error) {
+	updated, err := s.habitRepo.UpdateHabit(ctx, habit)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recordSchedule(ctx, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// recordSchedule записывает текущее расписание привычки как редакцию, действующую с "сегодня" владельца
+func (s *HabitService) recordSchedule(ctx context.Context, habit *domain.Habit) error {
+	today, err := s.userToday(ctx, habit.UserID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.scheduleRepo.CreateScheduleRevision(ctx, domain.NewScheduleRevision(habit, today)); err != nil {
+		return fmt.Errorf("failed to save schedule revision: %w", err)
+	}
+	return nil
+}
+
+// attachSkips загружает уважительные пропуски за период [from, to] и передает их привычкам
+func (s *HabitService) attachSkips(ctx context.Context, from, to time.Time, habits ...*domain.Habit) error {
+	if len(habits) == 0 {
+		return nil
+	}
+
+	habitIDs := make([]int, len(habits))
+	for i, habit := range habits {
+		habitIDs[i] = habit.ID
+	}
+
+	skips, err := s.skipRepo.GetSkipsByHabitIDs(ctx, habitIDs, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to get skips: %w", err)
+	}
+
+	for _, habit := range habits {
+		habit.SetSkippedDays(skips)
+	}
+	return nil
 }
 
 // daysToString преобразует массив дней в строку "1,3,5"
@@ -182,23 +733,122 @@ func (s *HabitService) daysToString(days []int) string {
 	return strings.Join(strs, ",")
 }
 
-// goWeekdayToInt преобразует Go weekday (0=Sunday) в интервал (1=Monday, 7=Sunday)
-func (s *HabitService) goWeekdayToInt(wd time.Weekday) int {
-	// Go: Sunday=0, Monday=1, ..., Saturday=6
-	// Нам нужно: Monday=1, ..., Sunday=7
-	if wd == 0 { // Sunday
-		return 7
+// RecomputeStreak пересчитывает стрик привычки по всей истории логов
+func (s *HabitService) RecomputeStreak(ctx context.Context, habitID int) (*domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit: %w", err)
 	}
-	return int(wd)
+
+	return s.recomputeStreak(ctx, habit)
 }
 
-// containsDay проверяет, содержит ли строка дней определенный день
-func (s *HabitService) containsDay(daysStr string, day int) bool {
-	parts := strings.Split(daysStr, ",")
-	for _, part := range parts {
-		if strings.TrimSpace(part) == strconv.Itoa(day) {
-			return true
+// RecomputeUserStreaks пересчитывает стрики всех привычек пользователя
+func (s *HabitService) RecomputeUserStreaks(ctx context.Context, userID int) ([]*domain.Habit, error) {
+	habits, err := s.habitRepo.GetHabitsByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user habits: %w", err)
+	}
+
+	result := make([]*domain.Habit, 0, len(habits))
+	for _, habit := range habits {
+		updated, err := s.recomputeStreak(ctx, habit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recompute streak for habit %d: %w", habit.ID, err)
 		}
+		result = append(result, updated)
 	}
-	return false
+
+	return result, nil
+}
+
+// recomputeStreak вычисляет стрик привычки по логам (у привычки-отказа - по срывам) на "сегодня" владельца и сохраняет его
+func (s *HabitService) recomputeStreak(ctx context.Context, habit *domain.Habit) (*domain.Habit, error) {
+	today, logDate, err := s.userLogDate(ctx, habit.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var state domain.StreakState
+	if habit.IsQuit() {
+		// Стрик отказа считается по срывам, а не по логам
+		relapses, err := s.relapseRepo.GetRelapsesByHabitID(ctx, habit.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relapses: %w", err)
+		}
+		state = habit.ComputeQuitStreak(relapseDates(relapses), today)
+	} else {
+		loggedDates, err := s.loadStreakHistory(ctx, habit, today)
+		if err != nil {
+			return nil, err
+		}
+		// В окне льготы вчерашний день еще можно отметить: пока он не отмечен, стрик считается на него
+		if logDate.Before(today) && !slices.ContainsFunc(loggedDates, logDate.Equal) {
+			today = logDate
+		}
+		state = domain.ComputeStreak(habit, loggedDates, today)
+	}
+	habit.ApplyStreak(state)
+
+	// Сохраняется только стрик: привычка могла быть загружена давно, и запись целиком
+	// откатила бы параллельную выработку или окончание челленджа
+	updated, err := s.habitRepo.UpdateStreak(ctx, habit.ID, state.CurrentStreak, state.BestStreak, state.LastCompletedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Стрик уже сохранен, поэтому неудачное начисление заморозки его не откатывает
+	if err := s.freezeService.earnForStreak(ctx, habit, state); err != nil {
+		logger.Warn("Failed to earn streak freeze", zap.Int("habit_id", habit.ID), zap.Error(err))
+	}
+
+	return updated, nil
+}
+
+// missBreaksStreak проверяет, обрывает ли пропуск day действующий стрик привычки
+func (s *HabitService) missBreaksStreak(ctx context.Context, habit *domain.Habit, day time.Time) (bool, error) {
+	loggedDates, err := s.loadStreakHistory(ctx, habit, day)
+	if err != nil {
+		return false, err
+	}
+
+	logged := make(map[time.Time]bool, len(loggedDates))
+	for _, date := range loggedDates {
+		logged[domain.DateOf(date)] = true
+	}
+	if len(habit.MissedDays(day, day, logged)) == 0 {
+		return false, nil
+	}
+
+	// Стрик на день пропуска без учета самого дня
+	return domain.ComputeStreak(habit, loggedDates, day).CurrentStreak > 0, nil
+}
+
+// loadStreakHistory загружает выполненные дни привычки и передает ей паузы, пропуски и замороженные дни до today
+func (s *HabitService) loadStreakHistory(ctx context.Context, habit *domain.Habit, today time.Time) ([]time.Time, error) {
+	logs, err := s.logRepo.GetLogsByHabitID(ctx, habit.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs: %w", err)
+	}
+
+	loggedDates := habit.CompletedDates(logs)
+
+	// Паузы, пропуски и редакции расписания нужны за всю историю логов
+	if err := s.attachPauses(ctx, time.Time{}, today, habit); err != nil {
+		return nil, err
+	}
+	if err := s.attachSchedules(ctx, habit); err != nil {
+		return nil, err
+	}
+	if err := s.attachSkips(ctx, time.Time{}, today, habit); err != nil {
+		return nil, err
+	}
+
+	frozen, err := s.freezeService.frozenDays(ctx, habit.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get frozen days: %w", err)
+	}
+	habit.SetFrozenDays(frozen)
+
+	return loggedDates, nil
 }
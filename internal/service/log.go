@@ -6,17 +6,41 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"HobitsService/internal/config"
 	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
 	"HobitsService/internal/repository"
 )
 
+var (
+	// ErrUnauthorized возвращается при действии над чужой привычкой или логом
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrLogNotFound возвращается, если лог не найден
+	ErrLogNotFound = errors.New("log not found")
+	// ErrFutureLogDate возвращается при отметке выполнения на будущую дату
+	ErrFutureLogDate = errors.New("logged date is in the future")
+	// ErrLogDateOutsideBackfill возвращается, если дата старше допустимого окна отметки задним числом
+	ErrLogDateOutsideBackfill = errors.New("logged date is outside the backfill window")
+	// ErrHabitNotScheduled возвращается при отметке задним числом дня, не запланированного для привычки
+	ErrHabitNotScheduled = errors.New("habit is not scheduled for this date")
+	// ErrQuitHabitCompletion возвращается при отметке выполнения привычки-отказа: по ней записываются срывы
+	ErrQuitHabitCompletion = errors.New("quit habits are tracked by relapses, not completions")
+	// ErrDayAlreadyLogged возвращается при пропуске дня, за который уже есть выполнение
+	ErrDayAlreadyLogged = errors.New("habit is already logged for this date")
+)
+
 // LogService сервис для логирования выполнений привычек
 type LogService struct {
 	logRepo      repository.HabitLogRepository
 	habitRepo    repository.HabitRepository
 	reminderRepo repository.HabitReminderRepository
 	queueRepo    repository.StreakResetQueueRepository
+	skipRepo     repository.SkipRepository
 	habitService *HabitService
+	backfillCfg  config.BackfillConfig
 }
 
 // NewLogService создает новый LogService
@@ -25,113 +49,286 @@ func NewLogService(
 	habitRepo repository.HabitRepository,
 	reminderRepo repository.HabitReminderRepository,
 	queueRepo repository.StreakResetQueueRepository,
+	skipRepo repository.SkipRepository,
 	habitService *HabitService,
+	backfillCfg config.BackfillConfig,
 ) *LogService {
 	return &LogService{
 		logRepo:      logRepo,
 		habitRepo:    habitRepo,
 		reminderRepo: reminderRepo,
 		queueRepo:    queueRepo,
+		skipRepo:     skipRepo,
 		habitService: habitService,
+		backfillCfg:  backfillCfg,
 	}
 }
 
-// LogCompletion логирует выполнение привычки и обновляет стрик
-func (s *LogService) LogCompletion(ctx context.Context, habitID, userID int, comment string) (*domain.HabitLog, error) {
+// LogCompletion логирует выполнение привычки и обновляет стрик.
+// Нулевая loggedDate означает текущий день владельца привычки (в окне льготы - предыдущий); более ранняя дата - отметку задним числом
+// в пределах BackfillConfig.MaxDays, и только на запланированный день.
+// У количественной привычки value прибавляется к значению за день, у привычки с несколькими
+// выполнениями за день создается очередной лог; день засчитывается только по достижении цели.
+// Возвращает лог и прогресс за день.
+func (s *LogService) LogCompletion(ctx context.Context, habitID, userID int, comment string, loggedDate time.Time, value float64) (*domain.HabitLog, domain.DayProgress, error) {
 	// Получаем привычку
 	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get habit: %w", err)
+		return nil, domain.DayProgress{}, fmt.Errorf("failed to get habit: %w", err)
 	}
 
 	if habit.UserID != userID {
-		return nil, errors.New("unauthorized")
+		return nil, domain.DayProgress{}, ErrUnauthorized
 	}
 
-	today := time.Now()
-	todayDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	if habit.IsQuit() {
+		return nil, domain.DayProgress{}, ErrQuitHabitCompletion
+	}
 
-	// Проверяем, уже ли выполнена сегодня
-	existingLog, err := s.logRepo.GetLogByHabitIDAndDate(ctx, habitID, todayDate)
-	if err == nil && existingLog != nil {
-		// Уже выполнена, возвращаем существующий логи
-		return existingLog, nil
+	if value < 0 || (habit.IsQuantitative() && value == 0) {
+		return nil, domain.DayProgress{}, domain.ErrInvalidLogValue
 	}
 
-	// Создаем новый лог
-	log := domain.NewHabitLog(habitID, userID, todayDate, comment)
-	createdLog, err := s.logRepo.CreateLog(ctx, log)
+	// "Сегодня" определяется в часовом поясе владельца привычки и с учетом начала его дня;
+	// в окне льготы после начала дня выполнение без даты засчитывается в предыдущий день
+	todayDate, logDate, err := s.habitService.userLogDate(ctx, habit.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create log: %w", err)
+		return nil, domain.DayProgress{}, err
+	}
+
+	if !loggedDate.IsZero() {
+		logDate = domain.DateOf(loggedDate)
+		if err := s.habitService.attachSchedules(ctx, habit); err != nil {
+			return nil, domain.DayProgress{}, err
+		}
+		if err := s.validateBackfill(habit, logDate, todayDate); err != nil {
+			return nil, domain.DayProgress{}, err
+		}
+	}
+
+	if !habit.IsWithinWindow(logDate) {
+		return nil, domain.DayProgress{}, domain.ErrOutsideChallenge
+	}
+
+	// Проверяем, сколько выполнений уже отмечено за этот день
+	dayLogs, err := s.logRepo.GetLogsByHabitIDAndDate(ctx, habitID, logDate, logDate)
+	if err != nil {
+		return nil, domain.DayProgress{}, fmt.Errorf("failed to get day logs: %w", err)
+	}
+	wasComplete := habit.Progress(dayLogs).Completed
+
+	var log *domain.HabitLog
+	switch {
+	case habit.IsQuantitative() && len(dayLogs) > 0:
+		// Прибавляем значение к уже записанному за день
+		log, err = s.logRepo.AddLogValue(ctx, dayLogs[0].ID, value, comment)
+		if err != nil {
+			return nil, domain.DayProgress{}, fmt.Errorf("failed to add log value: %w", err)
+		}
+		dayLogs = []*domain.HabitLog{log}
+	case len(dayLogs) >= habit.GetDailyCount():
+		// Уже выполнена нужное число раз, возвращаем последний лог
+		return dayLogs[0], habit.Progress(dayLogs), nil
+	default:
+		// Создаем новый лог со следующим номером выполнения за день;
+		// логи отсортированы по убыванию номера, а номера после удаления могут идти с пропусками
+		newLog := domain.NewHabitLog(habitID, userID, logDate, comment)
+		if len(dayLogs) > 0 {
+			newLog.CompletionNumber = dayLogs[0].CompletionNumber + 1
+		}
+		if value > 0 {
+			newLog.AddValue(value)
+		}
+		log, err = s.logRepo.CreateLog(ctx, newLog)
+		if err != nil {
+			return nil, domain.DayProgress{}, fmt.Errorf("failed to create log: %w", err)
+		}
+		dayLogs = append(dayLogs, log)
+	}
+
+	// День засчитывается только по достижении цели
+	progress := habit.Progress(dayLogs)
+	if !progress.Completed || wasComplete {
+		return log, progress, nil
 	}
 
 	// Обновляем статус напоминания
-	reminder, err := s.reminderRepo.GetReminderByHabitIDAndDate(ctx, habitID, todayDate)
+	reminder, err := s.reminderRepo.GetReminderByHabitIDAndDate(ctx, habitID, logDate)
 	if err == nil && reminder != nil {
 		reminder.MarkAsCompleted()
 		_, _ = s.reminderRepo.UpdateReminder(ctx, reminder)
 	}
 
-	// Обновляем стрик привычки
-	if err := s.updateStreak(ctx, habit); err != nil {
+	// Пересчитываем стрик привычки по истории логов и проверяем, не выработана ли она
+	if updated, err := s.habitService.recomputeStreak(ctx, habit); err != nil {
 		// Логируем ошибку но не прерываем основной процесс
 		fmt.Printf("failed to update streak: %v\n", err)
+	} else if _, err := s.habitService.checkGraduation(ctx, updated); err != nil {
+		logger.Error("Failed to check habit graduation", zap.Int("habit_id", habit.ID), zap.Error(err))
 	}
 
 	// Удаляем из очереди сброса если была добавлена
-	queueEntry, _ := s.queueRepo.GetQueueEntryByHabitIDAndDate(ctx, habitID, todayDate)
-	if queueEntry != nil {
-		_ = s.queueRepo.DeleteQueueEntry(ctx, queueEntry.ID)
+	s.dropQueueEntry(ctx, habitID, logDate)
+
+	return log, progress, nil
+}
+
+// SkipDay отмечает запланированный день как пропущенный по уважительной причине: день не обрывает стрик,
+// напоминание на него помечается пропущенным. Нулевая date означает текущий день владельца привычки,
+// более ранняя дата допустима в тех же пределах, что и отметка выполнения задним числом.
+func (s *LogService) SkipDay(ctx context.Context, habitID, userID int, date time.Time, reason string) (*domain.Skip, *domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get habit: %w", err)
+	}
+	if habit.UserID != userID {
+		return nil, nil, ErrUnauthorized
+	}
+	if !habit.CanSkip() {
+		return nil, nil, domain.ErrSkipNotSupported
 	}
 
-	return createdLog, nil
+	todayDate, skipDate, err := s.habitService.userLogDate(ctx, habit.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !date.IsZero() {
+		skipDate = domain.DateOf(date)
+	}
+
+	// Пропустить можно только запланированный день; паузы и редакции нужны для проверки расписания
+	if err := s.habitService.attachSchedules(ctx, habit); err != nil {
+		return nil, nil, err
+	}
+	if err := s.validateBackfill(habit, skipDate, todayDate); err != nil {
+		return nil, nil, err
+	}
+	if err := s.habitService.attachPauses(ctx, skipDate, skipDate, habit); err != nil {
+		return nil, nil, err
+	}
+	if !habit.IsScheduledOn(skipDate) {
+		return nil, nil, ErrHabitNotScheduled
+	}
+
+	dayLogs, err := s.logRepo.GetLogsByHabitIDAndDate(ctx, habitID, skipDate, skipDate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get day logs: %w", err)
+	}
+	if habit.Progress(dayLogs).Completed {
+		return nil, nil, ErrDayAlreadyLogged
+	}
+
+	skip, err := s.skipRepo.CreateSkip(ctx, domain.NewSkip(habitID, userID, skipDate, reason))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create skip: %w", err)
+	}
+
+	reminder, err := s.reminderRepo.GetReminderByHabitIDAndDate(ctx, habitID, skipDate)
+	if err == nil && reminder != nil {
+		reminder.MarkAsSkipped()
+		_, _ = s.reminderRepo.UpdateReminder(ctx, reminder)
+	}
+
+	// Пропуск мог уже попасть в очередь сброса
+	s.dropQueueEntry(ctx, habitID, skipDate)
+
+	// Пересчет восстанавливает стрик, если пропуск уже был обработан как невыполнение
+	updated, err := s.habitService.recomputeStreak(ctx, habit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to recompute streak: %w", err)
+	}
+
+	return skip, updated, nil
 }
 
-// updateStreak обновляет стрик привычки
-func (s *LogService) updateStreak(ctx context.Context, habit *domain.Habit) error {
-	today := time.Now()
-	todayDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+// GetSkips получает уважительные пропуски привычки за период
+func (s *LogService) GetSkips(ctx context.Context, habitID int, from, to time.Time) ([]*domain.Skip, error) {
+	return s.skipRepo.GetSkipsByHabitIDs(ctx, []int{habitID}, from, to)
+}
 
-	// Если это первое выполнение
-	if !habit.LastCompletedDate.Valid {
-		habit.IncreaseStreak()
-		habit.UpdateLastCompletedDate(todayDate)
-		_, err := s.habitRepo.UpdateHabit(ctx, habit)
+// DeleteLog удаляет лог выполнения пользователя и откатывает стрик так, как если бы этого дня не было
+func (s *LogService) DeleteLog(ctx context.Context, logID, userID int) (*domain.Habit, error) {
+	log, err := s.logRepo.GetLogByID(ctx, logID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrLogNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log: %w", err)
+	}
 
-		return err
+	if log.UserID != userID {
+		return nil, ErrUnauthorized
 	}
 
-	lastCompleted := habit.LastCompletedDate.Time
-	lastCompletedDate := time.Date(lastCompleted.Year(), lastCompleted.Month(), lastCompleted.Day(), 0, 0, 0, 0, lastCompleted.Location())
+	habit, err := s.habitRepo.GetHabitByID(ctx, log.HabitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit: %w", err)
+	}
 
-	// Проверяем, нарушен ли стрик
-	if s.isStreakBroken(habit, lastCompletedDate, todayDate) {
-		// Стрик нарушен - сбрасываем
-		if habit.CurrentStreak > habit.BestStreak {
-			habit.BestStreak = habit.CurrentStreak
+	if err := s.logRepo.DeleteLog(ctx, log.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete log: %w", err)
+	}
+
+	// Возвращаем напоминание за этот день в невыполненное, если оставшихся логов для цели не хватает
+	dayLogs, err := s.logRepo.GetLogsByHabitIDAndDate(ctx, habit.ID, log.LoggedDate, log.LoggedDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get day logs: %w", err)
+	}
+	if !habit.Progress(dayLogs).Completed {
+		reminder, err := s.reminderRepo.GetReminderByHabitIDAndDate(ctx, log.HabitID, log.LoggedDate)
+		if err == nil && reminder != nil {
+			reminder.MarkAsIncomplete()
+			if _, err := s.reminderRepo.UpdateReminder(ctx, reminder); err != nil {
+				logger.Error("Failed to update reminder", zap.Int("habit_id", habit.ID), zap.Error(err))
+			}
 		}
-		habit.CurrentStreak = 1
-	} else {
-		// Стрик продолжается
-		habit.IncreaseStreak()
 	}
 
-	habit.UpdateLastCompletedDate(todayDate)
-	_, err := s.habitRepo.UpdateHabit(ctx, habit)
+	// Пересчитываем стрик по оставшимся логам
+	updated, err := s.habitService.recomputeStreak(ctx, habit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recompute streak: %w", err)
+	}
 
-	return err
+	return updated, nil
 }
 
-// isStreakBroken проверяет, нарушен ли стрик между двумя датами
-func (s *LogService) isStreakBroken(habit *domain.Habit, lastDate, today time.Time) bool {
-	scheduledDays, _ := s.habitService.GetScheduledDaysBetween(context.Background(), habit.ID, lastDate.AddDate(0, 0, 1), today)
+// dropQueueEntry удаляет из очереди сброса запись привычки за день date. Запись, закрытая заморозкой,
+// остается: по ней заморозка уже потрачена и день числится замороженным. Ошибки логируются, не прерывая отметку.
+func (s *LogService) dropQueueEntry(ctx context.Context, habitID int, date time.Time) {
+	entry, err := s.queueRepo.GetQueueEntryByHabitIDAndDate(ctx, habitID, date)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return
+	}
+	if err != nil {
+		logger.Error("Failed to get streak reset queue entry", zap.Int("habit_id", habitID), zap.Error(err))
+		return
+	}
+	if entry.IsFrozen() {
+		return
+	}
 
-	// Если между последним выполнением и сегодня есть запланированные дни, которые не выполнены - стрик нарушен
-	if len(scheduledDays) > 1 {
-		return true
+	if err := s.queueRepo.DeleteQueueEntry(ctx, entry.ID); err != nil {
+		logger.Error("Failed to delete streak reset queue entry", zap.Int("habit_id", habitID), zap.Int("entry_id", entry.ID), zap.Error(err))
 	}
+}
 
-	return false
+// validateBackfill проверяет, что выполнение можно отметить за logDate
+func (s *LogService) validateBackfill(habit *domain.Habit, logDate, todayDate time.Time) error {
+	if logDate.After(todayDate) {
+		return ErrFutureLogDate
+	}
+	if logDate.Equal(todayDate) {
+		return nil
+	}
+	if logDate.Before(todayDate.AddDate(0, 0, -s.backfillCfg.MaxDays)) {
+		return fmt.Errorf("%w: at most %d days back", ErrLogDateOutsideBackfill, s.backfillCfg.MaxDays)
+	}
+	if !habit.IsScheduledOn(logDate) {
+		return ErrHabitNotScheduled
+	}
+	return nil
 }
 
 // GetHabitLogs получает логи привычки
@@ -146,19 +343,25 @@ func (s *LogService) GetHabitLogsByDateRange(ctx context.Context, habitID int, f
 
 // GetCompletionRate получает процент выполнения за период
 func (s *LogService) GetCompletionRate(ctx context.Context, habitID int, from, to time.Time) (float64, error) {
-	scheduledDays, err := s.habitService.GetScheduledDaysBetween(ctx, habitID, from, to)
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
 	if err != nil {
 		return 0, err
 	}
 
-	if len(scheduledDays) == 0 {
-		return 0, nil
+	return s.habitService.completionRate(ctx, habit, from, to)
+}
+
+// GetValueStats получает сумму и среднее значений количественной привычки за период
+func (s *LogService) GetValueStats(ctx context.Context, habitID int, from, to time.Time) (domain.ValueStats, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return domain.ValueStats{}, err
 	}
 
-	count, err := s.logRepo.CountLogsByHabitIDAndDate(ctx, habitID, from, to)
+	logs, err := s.logRepo.GetLogsByHabitIDAndDate(ctx, habitID, from, to)
 	if err != nil {
-		return 0, err
+		return domain.ValueStats{}, err
 	}
 
-	return float64(count) / float64(len(scheduledDays)) * 100, nil
+	return habit.ComputeValueStats(logs), nil
 }
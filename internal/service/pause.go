@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"HobitsService/internal/config"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/repository"
+)
+
+var (
+	// ErrPauseNotFound возвращается, если пауза не найдена
+	ErrPauseNotFound = errors.New("pause not found")
+	// ErrPauseStartOutsideBackfill возвращается, если пауза начинается раньше окна отметки задним числом
+	ErrPauseStartOutsideBackfill = errors.New("pause start date is outside the backfill window")
+)
+
+// PauseService сервис для управления паузами привычек и отпусками пользователя
+type PauseService struct {
+	pauseRepo    repository.PauseRepository
+	habitRepo    repository.HabitRepository
+	habitService *HabitService
+	backfillCfg  config.BackfillConfig
+}
+
+// NewPauseService создает новый PauseService
+func NewPauseService(
+	pauseRepo repository.PauseRepository,
+	habitRepo repository.HabitRepository,
+	habitService *HabitService,
+	backfillCfg config.BackfillConfig,
+) *PauseService {
+	return &PauseService{
+		pauseRepo:    pauseRepo,
+		habitRepo:    habitRepo,
+		habitService: habitService,
+		backfillCfg:  backfillCfg,
+	}
+}
+
+// CreatePause ставит на паузу привычку habitID или, при habitID 0, все привычки пользователя (отпуск).
+// Пауза может начинаться в прошлом в пределах BackfillConfig.MaxDays - тогда стрики пересчитываются,
+// и уже обнуленные за эти дни стрики восстанавливаются.
+func (s *PauseService) CreatePause(ctx context.Context, userID, habitID int, start, end time.Time, reason string) (*domain.Pause, error) {
+	if habitID != 0 {
+		habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get habit: %w", err)
+		}
+		if habit.UserID != userID {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	todayDate, err := s.habitService.userToday(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pause, err := domain.NewPause(userID, habitID, start, end, reason)
+	if err != nil {
+		return nil, err
+	}
+	if pause.StartDate.Before(todayDate.AddDate(0, 0, -s.backfillCfg.MaxDays)) {
+		return nil, fmt.Errorf("%w: at most %d days back", ErrPauseStartOutsideBackfill, s.backfillCfg.MaxDays)
+	}
+
+	created, err := s.pauseRepo.CreatePause(ctx, pause)
+	if err != nil {
+		return nil, err
+	}
+
+	if created.StartDate.Before(todayDate) {
+		if habitID != 0 {
+			_, err = s.habitService.RecomputeStreak(ctx, habitID)
+		} else {
+			_, err = s.habitService.RecomputeUserStreaks(ctx, userID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to recompute streaks: %w", err)
+		}
+	}
+
+	return created, nil
+}
+
+// ListPauses получает паузы пользователя; при habitID не 0 - только действующие на эту привычку, включая отпуска
+func (s *PauseService) ListPauses(ctx context.Context, userID, habitID int) ([]*domain.Pause, error) {
+	pauses, err := s.pauseRepo.GetPausesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if habitID == 0 {
+		return pauses, nil
+	}
+
+	var result []*domain.Pause
+	for _, pause := range pauses {
+		if pause.IsVacation() || int(pause.HabitID.Int32) == habitID {
+			result = append(result, pause)
+		}
+	}
+	return result, nil
+}
+
+// CancelPause отменяет паузу пользователя. Прошедшие дни паузы остаются на паузе.
+func (s *PauseService) CancelPause(ctx context.Context, pauseID, userID int) (*domain.Pause, error) {
+	pause, err := s.pauseRepo.GetPauseByID(ctx, pauseID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPauseNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pause: %w", err)
+	}
+	if pause.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	todayDate, err := s.habitService.userToday(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pause.Cancel(todayDate); err != nil {
+		return nil, err
+	}
+
+	return s.pauseRepo.UpdatePause(ctx, pause)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"HobitsService/internal/config"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/repository"
+)
+
+// RelapseService сервис для записи срывов и статистики привычек-отказов
+type RelapseService struct {
+	relapseRepo  repository.RelapseRepository
+	habitRepo    repository.HabitRepository
+	habitService *HabitService
+	backfillCfg  config.BackfillConfig
+}
+
+// NewRelapseService создает новый RelapseService
+func NewRelapseService(
+	relapseRepo repository.RelapseRepository,
+	habitRepo repository.HabitRepository,
+	habitService *HabitService,
+	backfillCfg config.BackfillConfig,
+) *RelapseService {
+	return &RelapseService{
+		relapseRepo:  relapseRepo,
+		habitRepo:    habitRepo,
+		habitService: habitService,
+		backfillCfg:  backfillCfg,
+	}
+}
+
+// LogRelapse записывает срыв по привычке-отказу и пересчитывает ее стрик.
+// Нулевая date означает текущий день владельца (в окне льготы - предыдущий); более ранняя дата допустима в пределах BackfillConfig.MaxDays.
+func (s *RelapseService) LogRelapse(ctx context.Context, habitID, userID int, date time.Time, note string) (*domain.Relapse, *domain.Habit, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get habit: %w", err)
+	}
+	if habit.UserID != userID {
+		return nil, nil, ErrUnauthorized
+	}
+	if !habit.IsQuit() {
+		return nil, nil, domain.ErrNotQuitHabit
+	}
+
+	todayDate, relapseDate, err := s.habitService.userLogDate(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !date.IsZero() {
+		relapseDate = domain.DateOf(date)
+		if relapseDate.After(todayDate) {
+			return nil, nil, ErrFutureLogDate
+		}
+		if relapseDate.Before(todayDate.AddDate(0, 0, -s.backfillCfg.MaxDays)) {
+			return nil, nil, fmt.Errorf("%w: at most %d days back", ErrLogDateOutsideBackfill, s.backfillCfg.MaxDays)
+		}
+	}
+	if !habit.IsWithinWindow(relapseDate) {
+		return nil, nil, domain.ErrOutsideChallenge
+	}
+
+	relapse, err := s.relapseRepo.CreateRelapse(ctx, domain.NewRelapse(habitID, userID, relapseDate, note))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create relapse: %w", err)
+	}
+
+	updated, err := s.habitService.recomputeStreak(ctx, habit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to recompute streak: %w", err)
+	}
+
+	return relapse, updated, nil
+}
+
+// ListRelapses получает срывы привычки, последние первыми
+func (s *RelapseService) ListRelapses(ctx context.Context, habitID int) ([]*domain.Relapse, error) {
+	return s.relapseRepo.GetRelapsesByHabitID(ctx, habitID)
+}
+
+// GetRelapseStats считает самую длинную чистую серию и частоту срывов за [from, to] по неделям или месяцам
+func (s *RelapseService) GetRelapseStats(ctx context.Context, habitID int, from, to time.Time, period domain.QuotaPeriod) (domain.RelapseStats, error) {
+	if period == "" {
+		period = domain.QuotaPeriodWeek
+	}
+	if period != domain.QuotaPeriodWeek && period != domain.QuotaPeriodMonth {
+		return domain.RelapseStats{}, domain.ErrInvalidStatsPeriod
+	}
+
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return domain.RelapseStats{}, fmt.Errorf("failed to get habit: %w", err)
+	}
+	if !habit.IsQuit() {
+		return domain.RelapseStats{}, domain.ErrNotQuitHabit
+	}
+
+	todayDate, err := s.habitService.userToday(ctx, habit.UserID)
+	if err != nil {
+		return domain.RelapseStats{}, err
+	}
+
+	relapses, err := s.relapseRepo.GetRelapsesByHabitID(ctx, habitID)
+	if err != nil {
+		return domain.RelapseStats{}, err
+	}
+
+	return habit.ComputeRelapseStats(relapseDates(relapses), from, to, todayDate, period), nil
+}
+
+// relapseDates возвращает даты срывов
+func relapseDates(relapses []*domain.Relapse) []time.Time {
+	dates := make([]time.Time, len(relapses))
+	for i, relapse := range relapses {
+		dates[i] = relapse.RelapseDate
+	}
+	return dates
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"go.uber.org/zap"
+
 	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
 	"HobitsService/internal/repository"
 )
 
@@ -13,6 +16,7 @@ import (
 type ReminderService struct {
 	reminderRepo repository.HabitReminderRepository
 	habitRepo    repository.HabitRepository
+	logRepo      repository.HabitLogRepository
 	habitService *HabitService
 }
 
@@ -20,11 +24,13 @@ type ReminderService struct {
 func NewReminderService(
 	reminderRepo repository.HabitReminderRepository,
 	habitRepo repository.HabitRepository,
+	logRepo repository.HabitLogRepository,
 	habitService *HabitService,
 ) *ReminderService {
 	return &ReminderService{
 		reminderRepo: reminderRepo,
 		habitRepo:    habitRepo,
+		logRepo:      logRepo,
 		habitService: habitService,
 	}
 }
@@ -37,9 +43,18 @@ func (s *ReminderService) CreateReminder(ctx context.Context, habitID, userID in
 
 // GenerateRemindersForToday генерирует напоминания на сегодня для пользователя
 func (s *ReminderService) GenerateRemindersForToday(ctx context.Context, userID int) ([]*domain.HabitReminder, error) {
-	today := time.Now()
-	todayDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	// "Сегодня" определяется в часовом поясе пользователя
+	todayDate, err := s.habitService.userToday(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
+	return s.GenerateRemindersForDate(ctx, userID, todayDate)
+}
+
+// GenerateRemindersForDate генерирует напоминания пользователя на указанную дату
+// Используется scheduler для повтора пропущенных запусков
+func (s *ReminderService) GenerateRemindersForDate(ctx context.Context, userID int, todayDate time.Time) ([]*domain.HabitReminder, error) {
 	// Получаем существующие напоминания на сегодня
 	existingReminders, err := s.reminderRepo.GetRemindersByUserIDAndDate(ctx, userID, todayDate)
 	if err != nil {
@@ -58,21 +73,47 @@ func (s *ReminderService) GenerateRemindersForToday(ctx context.Context, userID
 		return nil, fmt.Errorf("failed to get habits: %w", err)
 	}
 
+	// Привычкам на паузе напоминания не нужны, а заранее пропущенные дни сразу помечаются пропущенными
+	if err := s.habitService.attachPauses(ctx, todayDate, todayDate, habits...); err != nil {
+		return nil, err
+	}
+	if err := s.habitService.attachSkips(ctx, todayDate, todayDate, habits...); err != nil {
+		return nil, err
+	}
+	if err := s.habitService.attachSchedules(ctx, habits...); err != nil {
+		return nil, err
+	}
+
 	var allReminders []*domain.HabitReminder
 	allReminders = append(allReminders, existingReminders...)
 
 	for _, habit := range habits {
-		// Пропускаем если напоминание уже существует
-		if existingHabitIDs[habit.ID] {
+		// Пропускаем если напоминание уже существует; выработанным привычкам не напоминаем
+		if existingHabitIDs[habit.ID] || habit.IsCompleted {
 			continue
 		}
 
+		// Квотным привычкам напоминаем, только пока квота периода не выполнена
+		if habit.IsQuota() {
+			met, err := s.isQuotaMet(ctx, habit, todayDate)
+			if err != nil {
+				logger.Warn("Failed to check habit quota", zap.Int("habit_id", habit.ID), zap.Error(err))
+				continue
+			}
+			if met {
+				continue
+			}
+		}
+
 		// Проверяем, нужно ли подтверждение сегодня
-		if s.habitService.isHabitScheduledForDate(habit, todayDate) {
+		if habit.IsScheduledOn(todayDate) {
 			reminder := domain.NewHabitReminder(habit.ID, userID, todayDate)
+			if habit.IsSkippedOn(todayDate) {
+				reminder.MarkAsSkipped()
+			}
 			created, err := s.reminderRepo.CreateReminder(ctx, reminder)
 			if err != nil {
-				fmt.Printf("failed to create reminder for habit %d: %v\n", habit.ID, err)
+				logger.Warn("Failed to create reminder", zap.Int("habit_id", habit.ID), zap.Error(err))
 				continue
 			}
 			allReminders = append(allReminders, created)
@@ -82,6 +123,16 @@ func (s *ReminderService) GenerateRemindersForToday(ctx context.Context, userID
 	return allReminders, nil
 }
 
+// isQuotaMet проверяет, выполнена ли квота привычки за период, содержащий date
+func (s *ReminderService) isQuotaMet(ctx context.Context, habit *domain.Habit, date time.Time) (bool, error) {
+	start, end := habit.QuotaPeriodBounds(date)
+	logs, err := s.logRepo.GetLogsByHabitIDAndDate(ctx, habit.ID, start, end)
+	if err != nil {
+		return false, err
+	}
+	return len(habit.CompletedDates(logs)) >= int(habit.QuotaTarget.Int32), nil
+}
+
 // GetRemindersByDate получает напоминания на дату
 func (s *ReminderService) GetRemindersByDate(ctx context.Context, date time.Time) ([]*domain.HabitReminder, error) {
 	return s.reminderRepo.GetRemindersByDate(ctx, date)
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"HobitsService/internal/config"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/repository"
+)
+
+// maxFrozenDaysLimit максимальное число замороженных дней в ответе
+const maxFrozenDaysLimit = 100
+
+// StreakFreezeService сервис заморозок стрика: начисление за стрик, ручная выдача
+// и трата вместо сброса стрика за пропущенный день
+type StreakFreezeService struct {
+	freezeRepo repository.StreakFreezeRepository
+	queueRepo  repository.StreakResetQueueRepository
+	freezeCfg  config.StreakFreezeConfig
+}
+
+// NewStreakFreezeService создает новый StreakFreezeService
+func NewStreakFreezeService(
+	freezeRepo repository.StreakFreezeRepository,
+	queueRepo repository.StreakResetQueueRepository,
+	freezeCfg config.StreakFreezeConfig,
+) *StreakFreezeService {
+	return &StreakFreezeService{
+		freezeRepo: freezeRepo,
+		queueRepo:  queueRepo,
+		freezeCfg:  freezeCfg,
+	}
+}
+
+// GetBalance получает баланс заморозок пользователя
+func (s *StreakFreezeService) GetBalance(ctx context.Context, userID int) (*domain.StreakFreezeBalance, error) {
+	return s.freezeRepo.GetBalance(ctx, userID)
+}
+
+// GrantTokens выдает пользователю amount заморозок сверх лимита начисления
+func (s *StreakFreezeService) GrantTokens(ctx context.Context, userID, amount int) (*domain.StreakFreezeBalance, error) {
+	if amount < 1 {
+		return nil, domain.ErrInvalidFreezeAmount
+	}
+	return s.freezeRepo.AddTokens(ctx, userID, amount)
+}
+
+// GetFrozenDays получает историю пропусков, закрытых заморозкой; habitID 0 - по всем привычкам
+func (s *StreakFreezeService) GetFrozenDays(ctx context.Context, userID, habitID, limit int) ([]*domain.StreakResetQueue, error) {
+	if limit <= 0 || limit > maxFrozenDaysLimit {
+		limit = maxFrozenDaysLimit
+	}
+	return s.queueRepo.GetFrozenEntries(ctx, userID, habitID, limit)
+}
+
+// MaxTokens возвращает лимит заработанных заморозок
+func (s *StreakFreezeService) MaxTokens() int {
+	return s.freezeCfg.MaxTokens
+}
+
+// frozenDays получает дни привычки, пропуск в которые закрыт заморозкой
+func (s *StreakFreezeService) frozenDays(ctx context.Context, habitID int) ([]time.Time, error) {
+	return s.queueRepo.GetFrozenDatesByHabitID(ctx, habitID)
+}
+
+// earnForStreak начисляет заморозку, если стрик привычки достиг нового рубежа EarnEvery
+func (s *StreakFreezeService) earnForStreak(ctx context.Context, habit *domain.Habit, state domain.StreakState) error {
+	milestone := domain.FreezeMilestone(state.CurrentStreak, s.freezeCfg.EarnEvery)
+	if milestone == 0 || s.freezeCfg.MaxTokens < 1 {
+		return nil
+	}
+
+	earned, err := s.freezeRepo.EarnToken(ctx, habit.UserID, habit.ID, state.StreakStart, milestone, s.freezeCfg.MaxTokens)
+	if err != nil {
+		return err
+	}
+	if earned {
+		logger.Info("Streak freeze earned",
+			zap.Int("user_id", habit.UserID),
+			zap.Int("habit_id", habit.ID),
+			zap.Int("milestone", milestone),
+		)
+	}
+	return nil
+}
+
+// tryFreeze тратит заморозку пользователя на пропуск entry; false - заморозок не осталось
+func (s *StreakFreezeService) tryFreeze(ctx context.Context, entry *domain.StreakResetQueue) (bool, error) {
+	frozenAt, ok, err := s.freezeRepo.ConsumeToken(ctx, entry.UserID, entry.ID)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	entry.MarkAsFrozen(frozenAt)
+	return true, nil
+}
@@ -2,144 +2,409 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
+	"go.uber.org/zap"
+
+	"HobitsService/internal/config"
 	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
 	"HobitsService/internal/repository"
 )
 
+// ErrQueueEntryNotDeadLettered возвращается при попытке вернуть в очередь запись не из dead letter
+var ErrQueueEntryNotDeadLettered = errors.New("queue entry is not dead-lettered")
+
 // StreakResetService сервис для управления сбросом стриков
 type StreakResetService struct {
 	queueRepo    repository.StreakResetQueueRepository
+	userRepo     repository.UserRepository
 	habitRepo    repository.HabitRepository
 	logRepo      repository.HabitLogRepository
 	reminderRepo repository.HabitReminderRepository
 	habitService *HabitService
+	queueCfg     config.StreakQueueConfig
+
+	freezeService *StreakFreezeService
 }
 
 // NewStreakResetService создает новый StreakResetService
 func NewStreakResetService(
 	queueRepo repository.StreakResetQueueRepository,
+	userRepo repository.UserRepository,
 	habitRepo repository.HabitRepository,
 	logRepo repository.HabitLogRepository,
 	reminderRepo repository.HabitReminderRepository,
 	habitService *HabitService,
+	freezeService *StreakFreezeService,
+	queueCfg config.StreakQueueConfig,
 ) *StreakResetService {
 	return &StreakResetService{
 		queueRepo:    queueRepo,
+		userRepo:     userRepo,
 		habitRepo:    habitRepo,
 		logRepo:      logRepo,
 		reminderRepo: reminderRepo,
 		habitService: habitService,
+		queueCfg:     queueCfg,
+
+		freezeService: freezeService,
 	}
 }
 
-// CheckAndQueueStreakResets проверяет все привычки и добавляет в очередь на сброс
-// Должна вызваться каждый день вечером (например 23:59)
-func (s *StreakResetService) CheckAndQueueStreakResets(ctx context.Context) error {
-	//today := time.Now()
-	//todayDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+// streakCheckBatchSize размер порции привычек при массовой проверке стриков
+const streakCheckBatchSize = 500
+
+// CheckAndQueueStreakResets проверяет все активные привычки и добавляет пропуски в очередь на сброс
+// Должна вызваться каждый день вечером (например 23:55)
+// Возвращает количество проверенных привычек и добавленных в очередь пропусков
+func (s *StreakResetService) CheckAndQueueStreakResets(ctx context.Context) (checked, queued int, err error) {
+	return s.CheckAndQueueStreakResetsForDate(ctx, time.Time{})
+}
+
+// CheckAndQueueStreakResetsForDate проверяет все активные привычки так, как если бы проверка выполнялась в день date.
+// Привычки обрабатываются порциями по ID; на порцию приходится фиксированное число запросов
+// независимо от количества проверяемых дней.
+func (s *StreakResetService) CheckAndQueueStreakResetsForDate(ctx context.Context, date time.Time) (checked, queued int, err error) {
+	afterID := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return checked, queued, err
+		}
+
+		habits, err := s.habitRepo.GetActiveHabitsAfterID(ctx, afterID, streakCheckBatchSize)
+		if err != nil {
+			return checked, queued, fmt.Errorf("failed to get active habits: %w", err)
+		}
+		if len(habits) == 0 {
+			break
+		}
 
-	// Получаем все активные привычки
-	// Это неидеально, но без кэширования придется так
-	// TODO: добавить оптимизацию с получением привычек порциями
+		n, err := s.checkBatch(ctx, habits, date)
+		if err != nil {
+			return checked, queued, fmt.Errorf("failed to check habits after id %d: %w", afterID, err)
+		}
 
-	// Для каждой привычки проверяем, не пропустил ли пользователь
-	// В идеале это должен быть конкретный запрос в БД, но здесь логика сложная
-	fmt.Println("[StreakResetService] Starting streak check")
+		checked += len(habits)
+		queued += n
+		afterID = habits[len(habits)-1].ID
 
-	// В реальной реализации нужно было бы получить все активные привычки с последней датой проверки
-	// и для каждой проверить, есть ли пропуски
+		if len(habits) < streakCheckBatchSize {
+			break
+		}
+	}
 
-	return nil
+	return checked, queued, nil
 }
 
-// CheckHabitStreak проверяет, нужно ли сбросить стрик для привычки
-func (s *StreakResetService) CheckHabitStreak(ctx context.Context, habitID int) error {
-	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+// checkBatch проверяет порцию привычек: одним запросом получает логи за все проверяемые дни,
+// одним запросом ставит пропуски в очередь и одним обновляет last_checked_date
+func (s *StreakResetService) checkBatch(ctx context.Context, habits []*domain.Habit, date time.Time) (int, error) {
+	todays, err := s.usersToday(ctx, habits)
 	if err != nil {
-		return fmt.Errorf("failed to get habit: %w", err)
+		return 0, err
 	}
 
-	if !habit.IsActive {
-		return nil // Пропускаем неактивные привычки
+	// Пропуски оцениваются по расписанию, действовавшему в каждый из дней
+	if err := s.habitService.attachSchedules(ctx, habits...); err != nil {
+		return 0, err
 	}
 
-	today := time.Now()
-	todayDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
-
-	// Если привычка еще не выполнялась - пропускаем
-	if !habit.LastCompletedDate.Valid {
-		return nil
+	type checkRange struct {
+		habit    *domain.Habit
+		from, to time.Time
 	}
 
-	// Если уже проверили сегодня - пропускаем
-	if habit.LastCheckedDate.Valid {
-		lastCheckedDate := habit.LastCheckedDate.Time
-		lastCheckedDateOnly := time.Date(lastCheckedDate.Year(), lastCheckedDate.Month(), lastCheckedDate.Day(), 0, 0, 0, 0, lastCheckedDate.Location())
-		if lastCheckedDateOnly.Equal(todayDate) {
-			return nil
+	var (
+		ranges      []checkRange
+		quitHabits  []*domain.Habit
+		minFrom     time.Time
+		maxTo       time.Time
+		checkedDays = make(map[int]time.Time)
+	)
+
+	for _, habit := range habits {
+		todayDate, ok := todays[habit.UserID]
+		if !ok {
+			continue
+		}
+		if !date.IsZero() && date.Before(todayDate) {
+			todayDate = domain.DateOf(date)
+		}
+
+		// Стрик отказа растет каждый день без срыва, пропусков у него нет
+		if habit.IsQuit() {
+			if !habit.LastCheckedDate.Valid || domain.DateOf(habit.LastCheckedDate.Time).Before(todayDate) {
+				quitHabits = append(quitHabits, habit)
+				checkedDays[habit.ID] = todayDate
+			}
+			continue
+		}
+
+		from, ok := streakCheckStart(habit, todayDate)
+		if !ok {
+			continue
+		}
+		checkedDays[habit.ID] = todayDate
+
+		// Сегодня не проверяем - если не выполнено, проверим завтра
+		to := todayDate.AddDate(0, 0, -1)
+		if from.After(to) {
+			continue
+		}
+
+		ranges = append(ranges, checkRange{habit: habit, from: from, to: to})
+
+		// Для квоты нужны логи за весь период, в который попадает from
+		logsFrom := from
+		if habit.IsQuota() {
+			logsFrom, _ = habit.QuotaPeriodBounds(from)
+		}
+		if minFrom.IsZero() || logsFrom.Before(minFrom) {
+			minFrom = logsFrom
+		}
+		if to.After(maxTo) {
+			maxTo = to
 		}
 	}
 
-	// Получаем все запланированные дни с момента последней проверки до сегодня
-	lastCheckedDate := habit.LastCompletedDate.Time
-	if habit.LastCheckedDate.Valid {
-		lastCheckedDate = habit.LastCheckedDate.Time
+	var entries []*domain.StreakResetQueue
+	if len(ranges) > 0 {
+		habitIDs := make([]int, len(ranges))
+		for i, r := range ranges {
+			habitIDs[i] = r.habit.ID
+		}
+
+		logs, err := s.logRepo.GetLogsByHabitIDsAndDate(ctx, habitIDs, minFrom, maxTo)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get logs: %w", err)
+		}
+
+		// Дни на паузе и уважительные пропуски стрик не обрывают
+		checked := make([]*domain.Habit, len(ranges))
+		for i, r := range ranges {
+			checked[i] = r.habit
+		}
+		if err := s.habitService.attachPauses(ctx, minFrom, maxTo, checked...); err != nil {
+			return 0, err
+		}
+		if err := s.habitService.attachSkips(ctx, minFrom, maxTo, checked...); err != nil {
+			return 0, err
+		}
+
+		habitLogs := make(map[int][]*domain.HabitLog)
+		for _, log := range logs {
+			habitLogs[log.HabitID] = append(habitLogs[log.HabitID], log)
+		}
+
+		for _, r := range ranges {
+			// Засчитываются только дни, в которые достигнута цель привычки
+			logged := make(map[time.Time]bool)
+			for _, day := range r.habit.CompletedDates(habitLogs[r.habit.ID]) {
+				logged[day] = true
+			}
+
+			for _, day := range r.habit.MissedDays(r.from, r.to, logged) {
+				entries = append(entries, domain.NewStreakResetQueue(r.habit.ID, r.habit.UserID, day))
+			}
+		}
 	}
 
-	lastCheckedDateOnly := time.Date(lastCheckedDate.Year(), lastCheckedDate.Month(), lastCheckedDate.Day(), 0, 0, 0, 0, lastCheckedDate.Location())
+	for _, habit := range quitHabits {
+		if _, err := s.habitService.recomputeStreak(ctx, habit); err != nil {
+			return 0, fmt.Errorf("failed to recompute quit habit %d streak: %w", habit.ID, err)
+		}
+	}
 
-	scheduledDays, err := s.habitService.GetScheduledDaysBetween(ctx, habitID, lastCheckedDateOnly.AddDate(0, 0, 1), todayDate)
+	queued, err := s.queueRepo.CreateQueueEntries(ctx, entries)
 	if err != nil {
-		return fmt.Errorf("failed to get scheduled days: %w", err)
+		return 0, err
 	}
 
-	// Проверяем каждый запланированный день
-	for _, scheduledDay := range scheduledDays {
-		// Пропускаем сегодня - если не выполнено, проверим завтра
-		if scheduledDay.Equal(todayDate) {
-			continue
+	if err := s.habitRepo.UpdateLastCheckedDates(ctx, checkedDays); err != nil {
+		return queued, err
+	}
+
+	return queued, nil
+}
+
+// usersToday возвращает "сегодня" владельцев привычек в их часовых поясах.
+// В окне льготы после начала дня это еще предыдущий день: его можно отметить, поэтому он не проверяется.
+func (s *StreakResetService) usersToday(ctx context.Context, habits []*domain.Habit) (map[int]time.Time, error) {
+	seen := make(map[int]bool)
+	var userIDs []int
+	for _, habit := range habits {
+		if !seen[habit.UserID] {
+			seen[habit.UserID] = true
+			userIDs = append(userIDs, habit.UserID)
 		}
+	}
 
-		// Проверяем, выполнена ли привычка в этот день
-		log, err := s.logRepo.GetLogByHabitIDAndDate(ctx, habitID, scheduledDay)
-		if err != nil || log == nil {
-			// День пропущен - добавляем в очередь на сброс
-			queueEntry := domain.NewStreakResetQueue(habitID, habit.UserID, scheduledDay)
-			_, err := s.queueRepo.CreateQueueEntry(ctx, queueEntry)
-			if err != nil {
-				fmt.Printf("failed to create queue entry for habit %d, date %s: %v\n", habitID, scheduledDay.Format("2006-01-02"), err)
-			}
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	todays := make(map[int]time.Time, len(users))
+	for _, user := range users {
+		todays[user.ID] = user.LogDate()
+	}
+	return todays, nil
+}
+
+// streakCheckStart возвращает первый непроверенный день привычки.
+// false - привычка еще не выполнялась или уже проверена на todayDate.
+func streakCheckStart(habit *domain.Habit, todayDate time.Time) (time.Time, bool) {
+	if !habit.LastCompletedDate.Valid {
+		return time.Time{}, false
+	}
+
+	last := habit.LastCompletedDate.Time
+	if habit.LastCheckedDate.Valid {
+		last = habit.LastCheckedDate.Time
+		if !domain.DateOf(last).Before(todayDate) {
+			return time.Time{}, false
 		}
 	}
 
-	// Обновляем last_checked_date
-	habit.UpdateLastCheckedDate(todayDate)
-	_, err = s.habitRepo.UpdateHabit(ctx, habit)
+	return domain.DateOf(last).AddDate(0, 0, 1), true
+}
+
+// CheckHabitStreak проверяет, нужно ли сбросить стрик для привычки
+func (s *StreakResetService) CheckHabitStreak(ctx context.Context, habitID int) error {
+	return s.CheckHabitStreakForDate(ctx, habitID, time.Time{})
+}
+
+// CheckHabitStreakForDate проверяет стрик так, как если бы проверка выполнялась в день date.
+// Используется scheduler для повтора пропущенных проверок по порядку дат.
+// Нулевая date или дата позже сегодняшней означает "сегодня" владельца привычки.
+func (s *StreakResetService) CheckHabitStreakForDate(ctx context.Context, habitID int, date time.Time) error {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
 	if err != nil {
-		return fmt.Errorf("failed to update last_checked_date: %w", err)
+		return fmt.Errorf("failed to get habit: %w", err)
 	}
 
-	return nil
+	if !habit.IsActive {
+		return nil // Пропускаем неактивные привычки
+	}
+
+	_, err = s.checkBatch(ctx, []*domain.Habit{habit}, date)
+	return err
 }
 
 // ProcessQueueEntries обрабатывает очередь на сброс стриков
 // Должна вызваться после CheckAndQueueStreakResets (например 00:30)
-func (s *StreakResetService) ProcessQueueEntries(ctx context.Context) error {
-	entries, err := s.queueRepo.GetUnprocessedEntries(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get unprocessed entries: %w", err)
+// Записи захватываются порциями и обрабатываются пулом обработчиков; неудачные попытки
+// откладываются с экспоненциальной задержкой, а после MaxAttempts записи уходят в dead letter.
+// Возвращает количество обработанных и завершившихся ошибкой записей
+func (s *StreakResetService) ProcessQueueEntries(ctx context.Context) (processed, failed int, err error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return processed, failed, err
+		}
+
+		entries, err := s.queueRepo.ClaimEntries(ctx, s.queueCfg.BatchSize, s.queueCfg.LockTimeout)
+		if err != nil {
+			return processed, failed, fmt.Errorf("failed to claim queue entries: %w", err)
+		}
+		if len(entries) == 0 {
+			return processed, failed, nil
+		}
+
+		p, f := s.processBatch(ctx, entries)
+		processed += p
+		failed += f
 	}
+}
 
+// processBatch обрабатывает захваченные записи пулом из не более чем Workers обработчиков.
+// Записи одной привычки попадают к одному обработчику и обрабатываются по порядку дат.
+func (s *StreakResetService) processBatch(ctx context.Context, entries []*domain.StreakResetQueue) (processed, failed int) {
+	workers := max(1, min(s.queueCfg.Workers, len(entries)))
+	shards := make([][]*domain.StreakResetQueue, workers)
 	for _, entry := range entries {
-		if err := s.processQueueEntry(ctx, entry); err != nil {
-			fmt.Printf("failed to process queue entry %d: %v\n", entry.ID, err)
+		shard := entry.HabitID % workers
+		shards[shard] = append(shards[shard], entry)
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, shard := range shards {
+		if len(shard) == 0 {
+			continue
 		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, entry := range shard {
+				// Оставшиеся записи освободятся по истечении LockTimeout
+				if ctx.Err() != nil {
+					return
+				}
+
+				err := s.processQueueEntry(ctx, entry)
+				if err != nil {
+					s.failQueueEntry(ctx, entry, err)
+				}
+
+				mu.Lock()
+				if err != nil {
+					failed++
+				} else {
+					processed++
+				}
+				mu.Unlock()
+			}
+		}()
 	}
+	wg.Wait()
 
-	return nil
+	return processed, failed
+}
+
+// failQueueEntry сохраняет неудачную попытку и откладывает запись или переводит ее в dead letter
+func (s *StreakResetService) failQueueEntry(ctx context.Context, entry *domain.StreakResetQueue, cause error) {
+	entry.MarkAsFailed(cause, s.queueCfg.MaxAttempts, s.retryBackoff(entry.Attempts))
+
+	if entry.IsDeadLettered() {
+		logger.Error("Streak reset queue entry moved to dead letter",
+			zap.Int("entry_id", entry.ID),
+			zap.Int("habit_id", entry.HabitID),
+			zap.Int("attempts", entry.Attempts),
+			zap.Error(cause),
+		)
+	} else {
+		logger.Warn("Failed to process streak reset queue entry, will retry",
+			zap.Int("entry_id", entry.ID),
+			zap.Int("habit_id", entry.HabitID),
+			zap.Int("attempts", entry.Attempts),
+			zap.Time("next_attempt_at", entry.NextAttemptAt),
+			zap.Error(cause),
+		)
+	}
+
+	// Попытка должна сохраниться, даже если обработка прервана отменой контекста
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	if _, err := s.queueRepo.UpdateQueueEntry(ctx, entry); err != nil {
+		logger.Error("Failed to save streak reset queue entry attempt", zap.Int("entry_id", entry.ID), zap.Error(err))
+	}
+}
+
+// retryBackoff возвращает задержку перед следующей попыткой: BaseBackoff * 2^(attempts-1), не больше MaxBackoff
+func (s *StreakResetService) retryBackoff(attempts int) time.Duration {
+	backoff := s.queueCfg.BaseBackoff
+	for i := 1; i < attempts && backoff < s.queueCfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, s.queueCfg.MaxBackoff)
 }
 
 // processQueueEntry обрабатывает одну запись в очереди
@@ -149,28 +414,36 @@ func (s *StreakResetService) processQueueEntry(ctx context.Context, entry *domai
 		return fmt.Errorf("failed to get habit: %w", err)
 	}
 
-	// Сохраняем предыдущий стрик для аудита
-	entry.MarkAsProcessed(habit.CurrentStreak)
+	previousStreak := habit.CurrentStreak
 
-	// Если текущий стрик больше лучшего - обновляем лучший
-	if habit.CurrentStreak > habit.BestStreak {
-		habit.BestStreak = habit.CurrentStreak
+	// Вместо сброса действующего стрика тратим заморозку, если она есть.
+	// Повторная попытка не тратит вторую: заморозка уже записана на эту запись
+	if !entry.IsFrozen() {
+		breaks, err := s.habitService.missBreaksStreak(ctx, habit, entry.GetResetDate())
+		if err != nil {
+			return fmt.Errorf("failed to check streak: %w", err)
+		}
+		if breaks {
+			frozen, err := s.freezeService.tryFreeze(ctx, entry)
+			if err != nil {
+				return fmt.Errorf("failed to freeze streak: %w", err)
+			}
+			if frozen {
+				logger.Info("Streak frozen instead of reset",
+					zap.Int("habit_id", habit.ID),
+					zap.Time("reset_date", entry.GetResetDate()),
+				)
+			}
+		}
 	}
 
-	// Сбрасываем текущий стрик
-	habit.CurrentStreak = 0
-	habit.UpdatedAt = time.Now()
-
-	// Обновляем привычку
-	if _, err := s.habitRepo.UpdateHabit(ctx, habit); err != nil {
-		return fmt.Errorf("failed to update habit: %w", err)
+	// Пересчитываем стрик по логам: пропуск уже учтен в истории, заморозка - в замороженных днях
+	if _, err := s.habitService.recomputeStreak(ctx, habit); err != nil {
+		return fmt.Errorf("failed to recompute streak: %w", err)
 	}
 
-	// Обновляем запись в очереди
-	now := time.Now()
-	entry.ProcessedAt.Time = now
-	entry.ProcessedAt.Valid = true
-	entry.Processed = true
+	// Сохраняем предыдущий стрик для аудита и обновляем запись в очереди
+	entry.MarkAsProcessed(previousStreak)
 
 	if _, err := s.queueRepo.UpdateQueueEntry(ctx, entry); err != nil {
 		return fmt.Errorf("failed to update queue entry: %w", err)
@@ -196,3 +469,22 @@ func (s *StreakResetService) GetQueueEntry(ctx context.Context, entryID int) (*d
 func (s *StreakResetService) GetUnprocessedQueueEntries(ctx context.Context) ([]*domain.StreakResetQueue, error) {
 	return s.queueRepo.GetUnprocessedEntries(ctx)
 }
+
+// GetDeadLetterQueueEntries получает записи, исчерпавшие попытки обработки
+func (s *StreakResetService) GetDeadLetterQueueEntries(ctx context.Context, limit int) ([]*domain.StreakResetQueue, error) {
+	return s.queueRepo.GetDeadLetterEntries(ctx, limit)
+}
+
+// RequeueDeadLetterEntry возвращает запись из dead letter в очередь
+func (s *StreakResetService) RequeueDeadLetterEntry(ctx context.Context, entryID int) (*domain.StreakResetQueue, error) {
+	entry, err := s.queueRepo.GetQueueEntryByID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.IsDeadLettered() {
+		return nil, ErrQueueEntryNotDeadLettered
+	}
+
+	entry.Requeue()
+	return s.queueRepo.UpdateQueueEntry(ctx, entry)
+}
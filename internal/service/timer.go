@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"HobitsService/internal/config"
+	"HobitsService/internal/domain"
+	"HobitsService/internal/logger"
+	"HobitsService/internal/repository"
+)
+
+// TimerService сервис таймеров для привычек с целью в минутах
+type TimerService struct {
+	timerRepo  repository.TimerRepository
+	habitRepo  repository.HabitRepository
+	userRepo   repository.UserRepository
+	logRepo    repository.HabitLogRepository
+	logService *LogService
+	cfg        config.TimerConfig
+}
+
+// NewTimerService создает новый TimerService
+func NewTimerService(
+	timerRepo repository.TimerRepository,
+	habitRepo repository.HabitRepository,
+	userRepo repository.UserRepository,
+	logRepo repository.HabitLogRepository,
+	logService *LogService,
+	cfg config.TimerConfig,
+) *TimerService {
+	return &TimerService{
+		timerRepo:  timerRepo,
+		habitRepo:  habitRepo,
+		userRepo:   userRepo,
+		logRepo:    logRepo,
+		logService: logService,
+		cfg:        cfg,
+	}
+}
+
+// StartTimer запускает таймер привычки; у пользователя может быть запущен только один таймер
+func (s *TimerService) StartTimer(ctx context.Context, habitID, userID int) (*domain.TimerSession, error) {
+	habit, err := s.habitRepo.GetHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get habit: %w", err)
+	}
+	if habit.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+	if !habit.IsDurationTracked() {
+		return nil, domain.ErrNotDurationHabit
+	}
+
+	active, err := s.timerRepo.GetActiveTimer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return nil, domain.ErrTimerAlreadyRunning
+	}
+
+	return s.timerRepo.CreateTimer(ctx, domain.NewTimerSession(habitID, userID))
+}
+
+// GetActiveTimer получает запущенный таймер пользователя; nil - таймер не запущен
+func (s *TimerService) GetActiveTimer(ctx context.Context, userID int) (*domain.TimerSession, error) {
+	return s.timerRepo.GetActiveTimer(ctx, userID)
+}
+
+// StopTimer останавливает таймер пользователя и засчитывает его время по дням в часовом поясе пользователя.
+// Пока дневной минимум не набран, минуты копятся в сессиях; при его достижении создается лог
+// с накопленной длительностью, после - время прибавляется к логу. Возвращает остановленную сессию
+// и прогресс за день остановки.
+func (s *TimerService) StopTimer(ctx context.Context, userID int) (*domain.TimerSession, domain.DayProgress, error) {
+	active, err := s.timerRepo.GetActiveTimer(ctx, userID)
+	if err != nil {
+		return nil, domain.DayProgress{}, err
+	}
+	if active == nil {
+		return nil, domain.DayProgress{}, domain.ErrNoActiveTimer
+	}
+
+	session, err := s.timerRepo.StopTimer(ctx, active.ID, active.StopAt(time.Now(), s.cfg.MaxDuration))
+	if err != nil {
+		return nil, domain.DayProgress{}, err
+	}
+	if session == nil {
+		// Таймер остановлен параллельным запросом
+		return nil, domain.DayProgress{}, domain.ErrNoActiveTimer
+	}
+
+	habit, err := s.habitRepo.GetHabitByID(ctx, session.HabitID)
+	if err != nil {
+		return nil, domain.DayProgress{}, fmt.Errorf("failed to get habit: %w", err)
+	}
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, domain.DayProgress{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	var progress domain.DayProgress
+	for _, day := range session.DayDurations(user) {
+		progress, err = s.creditDay(ctx, habit, day, user)
+		if err != nil {
+			return nil, domain.DayProgress{}, err
+		}
+	}
+
+	return session, progress, nil
+}
+
+// creditDay засчитывает время сессии, пришедшееся на день day
+func (s *TimerService) creditDay(ctx context.Context, habit *domain.Habit, day domain.DayDuration, user *domain.User) (domain.DayProgress, error) {
+	dayLogs, err := s.logRepo.GetLogsByHabitIDAndDate(ctx, habit.ID, day.Date, day.Date)
+	if err != nil {
+		return domain.DayProgress{}, fmt.Errorf("failed to get day logs: %w", err)
+	}
+
+	value := day.Minutes
+	if len(dayLogs) == 0 {
+		// Лога еще нет: считаем все сессии дня и создаем лог, только если минимум набран
+		from, to := user.DayStart(day.Date), user.DayStart(day.Date.AddDate(0, 0, 1))
+		sessions, err := s.timerRepo.GetStoppedTimersByHabitID(ctx, habit.ID, from, to)
+		if err != nil {
+			return domain.DayProgress{}, err
+		}
+		value = domain.MinutesOn(sessions, day.Date, user)
+		if !habit.IsDayComplete(1, value) {
+			return domain.DayProgress{Target: habit.GetDailyCount(), Total: value}, nil
+		}
+	}
+
+	_, progress, err := s.logService.LogCompletion(ctx, habit.ID, habit.UserID, "", day.Date, value)
+	if errors.Is(err, ErrLogDateOutsideBackfill) || errors.Is(err, ErrHabitNotScheduled) {
+		// Часть сессии до начала дня не засчитывается в день, который уже нельзя отметить
+		logger.Warn("Timer minutes not credited", zap.Int("habit_id", habit.ID), zap.Time("date", day.Date), zap.Error(err))
+		return domain.DayProgress{Target: habit.GetDailyCount(), Total: value}, nil
+	}
+	if err != nil {
+		return domain.DayProgress{}, fmt.Errorf("failed to log timer minutes: %w", err)
+	}
+
+	return progress, nil
+}
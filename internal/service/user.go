@@ -21,7 +21,8 @@ func NewUserService(userRepo repository.UserRepository) *UserService {
 }
 
 // GetOrCreateUser получает пользователя или создает нового
-func (s *UserService) GetOrCreateUser(ctx context.Context, telegramID int64, firstName, lastName, username, languageCode string) (*domain.User, error) {
+// timezone применяется только при создании; пустая строка означает часовой пояс по умолчанию
+func (s *UserService) GetOrCreateUser(ctx context.Context, telegramID int64, firstName, lastName, username, languageCode, timezone string) (*domain.User, error) {
 	// Пытаемся найти существующего пользователя
 	user, err := s.userRepo.GetUserByTelegramID(ctx, telegramID)
 	if err == nil {
@@ -30,6 +31,11 @@ func (s *UserService) GetOrCreateUser(ctx context.Context, telegramID int64, fir
 
 	// Создаем нового пользователя
 	newUser := domain.NewUser(telegramID, firstName, lastName, username, languageCode)
+	if timezone != "" {
+		if err := newUser.SetTimezone(timezone); err != nil {
+			return nil, err
+		}
+	}
 	return s.userRepo.CreateUser(ctx, newUser)
 }
 
@@ -49,7 +55,8 @@ func (s *UserService) GetAllUsers(ctx context.Context) ([]*domain.User, error) {
 }
 
 // UpdateUser обновляет информацию пользователя
-func (s *UserService) UpdateUser(ctx context.Context, id int, firstName, lastName, username, languageCode string) (*domain.User, error) {
+// Пустой timezone оставляет текущий часовой пояс без изменений
+func (s *UserService) UpdateUser(ctx context.Context, id int, firstName, lastName, username, languageCode, timezone string) (*domain.User, error) {
 	user, err := s.userRepo.GetUserByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("user not found: %w", err)
@@ -60,6 +67,26 @@ func (s *UserService) UpdateUser(ctx context.Context, id int, firstName, lastNam
 	user.Username = username
 	user.LanguageCode = languageCode
 
+	if timezone != "" {
+		if err := user.SetTimezone(timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.userRepo.UpdateUser(ctx, user)
+}
+
+// SetDayBoundary устанавливает час начала дня пользователя и окно льготы для поздних отметок
+func (s *UserService) SetDayBoundary(ctx context.Context, id, startHour, graceMinutes int) (*domain.User, error) {
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if err := user.SetDayBoundary(startHour, graceMinutes); err != nil {
+		return nil, err
+	}
+
 	return s.userRepo.UpdateUser(ctx, user)
 }
 
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS scheduler_runs (
+    job_name VARCHAR(100) PRIMARY KEY,
+
+    last_success_at TIMESTAMPTZ NOT NULL,
+
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
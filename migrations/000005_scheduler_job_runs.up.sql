@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS scheduler_job_runs (
+    id SERIAL PRIMARY KEY,
+
+    job_name VARCHAR(100) NOT NULL,
+    trigger VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+
+    users_processed INTEGER DEFAULT 0,
+    habits_processed INTEGER DEFAULT 0,
+    failed_count INTEGER DEFAULT 0,
+
+    error TEXT,
+
+    CONSTRAINT valid_job_run_trigger CHECK (trigger IN ('schedule', 'manual', 'catch_up')),
+    CONSTRAINT valid_job_run_status CHECK (status IN ('running', 'succeeded', 'failed'))
+);
+
+CREATE INDEX idx_scheduler_job_runs_job_name_started_at ON scheduler_job_runs(job_name, started_at DESC);
+CREATE INDEX idx_scheduler_job_runs_started_at ON scheduler_job_runs(started_at DESC);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_streak_reset_queue_dead_lettered;
+DROP INDEX IF EXISTS idx_streak_reset_queue_pending;
+
+ALTER TABLE streak_reset_queue
+    DROP COLUMN IF EXISTS dead_lettered_at,
+    DROP COLUMN IF EXISTS locked_until,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS attempts;
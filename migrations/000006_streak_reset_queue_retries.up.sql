@@ -0,0 +1,14 @@
+ALTER TABLE streak_reset_queue
+    ADD COLUMN IF NOT EXISTS attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS last_error TEXT,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS dead_lettered_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_streak_reset_queue_pending
+    ON streak_reset_queue(next_attempt_at)
+    WHERE processed = false AND dead_lettered_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_streak_reset_queue_dead_lettered
+    ON streak_reset_queue(dead_lettered_at)
+    WHERE dead_lettered_at IS NOT NULL;
@@ -0,0 +1,10 @@
+UPDATE habits SET frequency = 'daily' WHERE frequency = 'interval';
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_interval_days;
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_frequency;
+ALTER TABLE habits ADD CONSTRAINT valid_frequency
+    CHECK (frequency IN ('daily', 'weekly', 'monthly'));
+
+ALTER TABLE habits
+    DROP COLUMN IF EXISTS anchor_date,
+    DROP COLUMN IF EXISTS interval_days;
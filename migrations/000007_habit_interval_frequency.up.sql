@@ -0,0 +1,10 @@
+ALTER TABLE habits
+    ADD COLUMN IF NOT EXISTS interval_days INTEGER,
+    ADD COLUMN IF NOT EXISTS anchor_date DATE;
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_frequency;
+ALTER TABLE habits ADD CONSTRAINT valid_frequency
+    CHECK (frequency IN ('daily', 'weekly', 'monthly', 'interval'));
+
+ALTER TABLE habits ADD CONSTRAINT valid_interval_days
+    CHECK (interval_days IS NULL OR interval_days >= 1);
@@ -0,0 +1,10 @@
+UPDATE habits SET frequency = 'daily' WHERE frequency = 'quota';
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_quota;
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_frequency;
+ALTER TABLE habits ADD CONSTRAINT valid_frequency
+    CHECK (frequency IN ('daily', 'weekly', 'monthly', 'interval'));
+
+ALTER TABLE habits
+    DROP COLUMN IF EXISTS quota_period,
+    DROP COLUMN IF EXISTS quota_target;
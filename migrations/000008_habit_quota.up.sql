@@ -0,0 +1,13 @@
+ALTER TABLE habits
+    ADD COLUMN IF NOT EXISTS quota_target INTEGER,
+    ADD COLUMN IF NOT EXISTS quota_period VARCHAR(10);
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_frequency;
+ALTER TABLE habits ADD CONSTRAINT valid_frequency
+    CHECK (frequency IN ('daily', 'weekly', 'monthly', 'interval', 'quota'));
+
+ALTER TABLE habits ADD CONSTRAINT valid_quota
+    CHECK (
+        (quota_target IS NULL OR quota_target >= 1)
+        AND (quota_period IS NULL OR quota_period IN ('week', 'month'))
+    );
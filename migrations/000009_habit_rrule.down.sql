@@ -0,0 +1,5 @@
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_rrule;
+
+ALTER TABLE habits
+    DROP COLUMN IF EXISTS rrule_start,
+    DROP COLUMN IF EXISTS rrule;
@@ -0,0 +1,6 @@
+ALTER TABLE habits
+    ADD COLUMN IF NOT EXISTS rrule TEXT,
+    ADD COLUMN IF NOT EXISTS rrule_start DATE;
+
+ALTER TABLE habits ADD CONSTRAINT valid_rrule
+    CHECK (rrule IS NULL OR rrule_start IS NOT NULL);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS habit_pauses (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- NULL - отпуск пользователя, ставит на паузу все его привычки
+    habit_id INTEGER REFERENCES habits(id) ON DELETE CASCADE,
+
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    cancelled_at TIMESTAMPTZ,
+
+    -- Отмененная до начала пауза хранится с end_date = start_date - 1
+    CONSTRAINT valid_pause_dates CHECK (end_date >= start_date OR cancelled_at IS NOT NULL)
+);
+
+CREATE INDEX idx_habit_pauses_user_id_dates ON habit_pauses(user_id, start_date, end_date);
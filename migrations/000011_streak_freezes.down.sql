@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_streak_reset_queue_frozen;
+ALTER TABLE streak_reset_queue DROP COLUMN IF EXISTS frozen_at;
+
+DROP TABLE IF EXISTS streak_freeze_earnings;
+DROP TABLE IF EXISTS streak_freeze_balances;
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS streak_freeze_balances (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    tokens INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT valid_freeze_tokens CHECK (tokens >= 0)
+);
+
+-- Заработанные за стрик заморозки: одна на каждый рубеж стрика, начатого в streak_start
+CREATE TABLE IF NOT EXISTS streak_freeze_earnings (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    habit_id INTEGER NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+
+    streak_start DATE NOT NULL,
+    milestone INTEGER NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_freeze_earning UNIQUE(habit_id, streak_start, milestone)
+);
+
+-- Пропуск, закрытый заморозкой вместо сброса стрика
+ALTER TABLE streak_reset_queue ADD COLUMN IF NOT EXISTS frozen_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_streak_reset_queue_frozen
+    ON streak_reset_queue(user_id, reset_date DESC)
+    WHERE frozen_at IS NOT NULL;
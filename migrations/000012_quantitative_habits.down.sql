@@ -0,0 +1,6 @@
+ALTER TABLE habit_logs DROP COLUMN IF EXISTS value;
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_target_value;
+ALTER TABLE habits
+    DROP COLUMN IF EXISTS unit,
+    DROP COLUMN IF EXISTS target_value;
@@ -0,0 +1,9 @@
+ALTER TABLE habits
+    ADD COLUMN IF NOT EXISTS target_value DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS unit VARCHAR(32);
+
+ALTER TABLE habits ADD CONSTRAINT valid_target_value
+    CHECK (target_value IS NULL OR target_value > 0);
+
+-- Накопленное за день значение; NULL - бинарная отметка
+ALTER TABLE habit_logs ADD COLUMN IF NOT EXISTS value DOUBLE PRECISION;
@@ -0,0 +1,8 @@
+DELETE FROM habit_logs WHERE completion_number > 1;
+
+ALTER TABLE habit_logs DROP CONSTRAINT IF EXISTS unique_habit_log_completion;
+ALTER TABLE habit_logs ADD CONSTRAINT unique_habit_log_per_day UNIQUE (habit_id, logged_date);
+ALTER TABLE habit_logs DROP COLUMN IF EXISTS completion_number;
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_daily_count;
+ALTER TABLE habits DROP COLUMN IF EXISTS daily_count;
@@ -0,0 +1,11 @@
+ALTER TABLE habits ADD COLUMN IF NOT EXISTS daily_count INTEGER;
+
+ALTER TABLE habits ADD CONSTRAINT valid_daily_count
+    CHECK (daily_count IS NULL OR daily_count >= 1);
+
+-- Несколько выполнений за день нумеруются с 1; уникальность номера защищает от превышения цели при гонке
+ALTER TABLE habit_logs ADD COLUMN IF NOT EXISTS completion_number INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE habit_logs DROP CONSTRAINT IF EXISTS unique_habit_log_per_day;
+ALTER TABLE habit_logs ADD CONSTRAINT unique_habit_log_completion
+    UNIQUE (habit_id, logged_date, completion_number);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS habit_relapses;
+
+UPDATE habits SET frequency = 'daily' WHERE frequency = 'quit';
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_frequency;
+ALTER TABLE habits ADD CONSTRAINT valid_frequency
+    CHECK (frequency IN ('daily', 'weekly', 'monthly', 'interval', 'quota'));
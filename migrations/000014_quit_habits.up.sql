@@ -0,0 +1,17 @@
+-- quit: привычка, от которой пользователь отказывается; стрик - дни с последнего срыва
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_frequency;
+ALTER TABLE habits ADD CONSTRAINT valid_frequency
+    CHECK (frequency IN ('daily', 'weekly', 'monthly', 'interval', 'quota', 'quit'));
+
+CREATE TABLE IF NOT EXISTS habit_relapses (
+    id SERIAL PRIMARY KEY,
+    habit_id INTEGER NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    relapse_date DATE NOT NULL,
+    note TEXT,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_habit_relapses_habit_id_date ON habit_relapses(habit_id, relapse_date);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS timer_sessions (
+    id SERIAL PRIMARY KEY,
+    habit_id INTEGER NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    started_at TIMESTAMPTZ NOT NULL,
+    -- NULL - таймер запущен
+    stopped_at TIMESTAMPTZ,
+
+    CONSTRAINT valid_timer_period CHECK (stopped_at IS NULL OR stopped_at >= started_at)
+);
+
+-- У пользователя запущен не больше чем один таймер
+CREATE UNIQUE INDEX idx_timer_sessions_running ON timer_sessions(user_id) WHERE stopped_at IS NULL;
+CREATE INDEX idx_timer_sessions_habit_id_started_at ON timer_sessions(habit_id, started_at);
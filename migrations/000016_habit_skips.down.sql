@@ -0,0 +1,3 @@
+ALTER TABLE habit_reminders DROP COLUMN IF EXISTS is_skipped;
+
+DROP TABLE IF EXISTS habit_skips;
@@ -0,0 +1,15 @@
+-- Уважительный пропуск запланированного дня: не обрывает и не продлевает стрик
+CREATE TABLE IF NOT EXISTS habit_skips (
+    id SERIAL PRIMARY KEY,
+    habit_id INTEGER NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    skip_date DATE NOT NULL,
+    reason TEXT,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_habit_skip_per_day UNIQUE (habit_id, skip_date)
+);
+
+ALTER TABLE habit_reminders ADD COLUMN IF NOT EXISTS is_skipped BOOLEAN NOT NULL DEFAULT FALSE;
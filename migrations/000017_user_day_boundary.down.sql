@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS log_grace_minutes,
+    DROP COLUMN IF EXISTS day_start_hour;
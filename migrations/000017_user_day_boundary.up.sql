@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS day_start_hour SMALLINT NOT NULL DEFAULT 0
+        CHECK (day_start_hour >= 0 AND day_start_hour <= 23),
+    ADD COLUMN IF NOT EXISTS log_grace_minutes INTEGER NOT NULL DEFAULT 0
+        CHECK (log_grace_minutes >= 0 AND log_grace_minutes <= 720);
@@ -0,0 +1,31 @@
+-- Редакции расписания привычки: каждая действует с effective_from до следующей
+CREATE TABLE IF NOT EXISTS habit_schedule_revisions (
+    id SERIAL PRIMARY KEY,
+    habit_id INTEGER NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+
+    effective_from DATE NOT NULL,
+
+    frequency VARCHAR(50) NOT NULL,
+    weekly_days VARCHAR(13),
+    monthly_days VARCHAR(255),
+    interval_days INTEGER,
+    anchor_date DATE,
+    quota_target INTEGER,
+    quota_period VARCHAR(10),
+    rrule TEXT,
+    rrule_start DATE,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_schedule_revision_per_day UNIQUE (habit_id, effective_from)
+);
+
+-- Текущее расписание существующих привычек считается действующим с их создания
+INSERT INTO habit_schedule_revisions (
+    habit_id, effective_from, frequency, weekly_days, monthly_days,
+    interval_days, anchor_date, quota_target, quota_period, rrule, rrule_start
+)
+SELECT id, created_at::date, frequency, weekly_days, monthly_days,
+    interval_days, anchor_date, quota_target, quota_period, rrule, rrule_start
+FROM habits
+ON CONFLICT (habit_id, effective_from) DO NOTHING;
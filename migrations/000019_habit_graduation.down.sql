@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS habit_events;
+
+DROP INDEX IF EXISTS idx_habits_formed;
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_graduation;
+
+ALTER TABLE habits
+    DROP COLUMN IF EXISTS graduation_weeks,
+    DROP COLUMN IF EXISTS graduation_rate,
+    DROP COLUMN IF EXISTS graduation_streak;
@@ -0,0 +1,29 @@
+ALTER TABLE habits
+    ADD COLUMN IF NOT EXISTS graduation_streak INTEGER,
+    ADD COLUMN IF NOT EXISTS graduation_rate INTEGER,
+    ADD COLUMN IF NOT EXISTS graduation_weeks INTEGER;
+
+ALTER TABLE habits ADD CONSTRAINT valid_graduation
+    CHECK (
+        (graduation_streak IS NULL OR graduation_streak >= 1)
+        AND (graduation_rate IS NULL OR (graduation_rate BETWEEN 1 AND 100))
+        AND (graduation_weeks IS NULL OR graduation_weeks >= 1)
+        AND ((graduation_rate IS NULL) = (graduation_weeks IS NULL))
+    );
+
+CREATE INDEX IF NOT EXISTS idx_habits_formed ON habits(user_id, completed_at) WHERE is_completed;
+
+-- Outbox событий привычек: строки сохраняются вместе с изменением привычки и публикуются отдельно
+CREATE TABLE IF NOT EXISTS habit_events (
+    id SERIAL PRIMARY KEY,
+    habit_id INTEGER NOT NULL REFERENCES habits(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_habit_events_unpublished ON habit_events(id) WHERE published_at IS NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_habits_challenge_end;
+
+ALTER TABLE habits DROP CONSTRAINT IF EXISTS valid_challenge_window;
+
+ALTER TABLE habits
+    DROP COLUMN IF EXISTS ends_on,
+    DROP COLUMN IF EXISTS starts_on;
@@ -0,0 +1,8 @@
+ALTER TABLE habits
+    ADD COLUMN IF NOT EXISTS starts_on DATE,
+    ADD COLUMN IF NOT EXISTS ends_on DATE;
+
+ALTER TABLE habits ADD CONSTRAINT valid_challenge_window
+    CHECK (starts_on IS NULL OR ends_on IS NULL OR ends_on >= starts_on);
+
+CREATE INDEX IF NOT EXISTS idx_habits_challenge_end ON habits(ends_on) WHERE is_active AND ends_on IS NOT NULL;